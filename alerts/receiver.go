@@ -0,0 +1,254 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerts
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/alerts
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/alerts/receiver.html
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// DefaultDedupWindow is the time in which just one trigger is created for a
+// cluster
+const DefaultDedupWindow = time.Hour
+
+// MaxNotificationSize is the maximum size of notification body in bytes,
+// larger notifications are refused
+const MaxNotificationSize = 1 << 20
+
+// DefaultClusterLabels contains names of labels that are checked (in this
+// order) to find cluster the alert belongs to
+var DefaultClusterLabels = []string{"cluster_id", "_id", "cluster"}
+
+// Action represents what has been done with an alert
+type Action string
+
+// all actions that can be done with an alert
+const (
+	Created Action = "created"
+	Skipped Action = "skipped"
+	Failed  Action = "failed"
+)
+
+// Result represents result of processing one alert
+type Result struct {
+	Alert   string `json:"alert"`
+	Cluster string `json:"cluster,omitempty"`
+	Action  Action `json:"action"`
+	Message string `json:"message,omitempty"`
+}
+
+// Receiver is a HTTP handler that accepts Alertmanager webhook notifications
+// and creates must-gather triggers for clusters with firing alerts
+type Receiver struct {
+	// REST API used to create triggers
+	API restapi.API
+
+	// name of user that creates triggers
+	Username string
+
+	// labels used to find cluster, the first one found is used
+	ClusterLabels []string
+
+	// names of alerts that are accepted, all alerts are accepted when the
+	// list is empty
+	AllowedAlerts []string
+
+	// time in which just one trigger is created for a cluster
+	DedupWindow time.Duration
+
+	// bearer token that needs to be sent in Authorization header, requests
+	// are not authenticated when it is empty
+	Token string
+
+	// function that maps value of cluster label to cluster name, the value
+	// is used as is when it is not set
+	Resolve func(value string) (string, error)
+
+	// function that checks triggers known to the controller and decides
+	// whether new trigger for the cluster would be a duplicate, triggers
+	// created since the given time are always duplicates; message is
+	// reported with the result even when the trigger is created. Triggers
+	// are not deduplicated when the function is not set.
+	Duplicate func(cluster string, since time.Time) (bool, string, error)
+
+	// function called for each processed alert, can be nil
+	Report func(Result)
+
+	// clock used for deduplication
+	Now func() time.Time
+
+	mutex sync.Mutex
+}
+
+// NewReceiver function constructs new receiver with default cluster labels
+// and deduplication window
+func NewReceiver(api restapi.API, username string) *Receiver {
+	return &Receiver{
+		API:           api,
+		Username:      username,
+		ClusterLabels: DefaultClusterLabels,
+		DedupWindow:   DefaultDedupWindow,
+		Now:           time.Now,
+	}
+}
+
+// allowed method checks whether the alert name is in allowlist
+func (receiver *Receiver) allowed(name string) bool {
+	if len(receiver.AllowedAlerts) == 0 {
+		return true
+	}
+	for _, allowed := range receiver.AllowedAlerts {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterLabel method returns value of the first cluster label found in
+// alert labels
+func (receiver *Receiver) clusterLabel(alert Alert) string {
+	for _, label := range receiver.ClusterLabels {
+		if value := alert.Labels[label]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// process method creates trigger for one alert if it is needed
+func (receiver *Receiver) process(alert Alert) Result {
+	result := Result{Alert: alert.Name(), Action: Skipped}
+
+	switch {
+	case !alert.Firing():
+		result.Message = "alert is not firing"
+		return result
+	case !receiver.allowed(alert.Name()):
+		result.Message = "alert is not allowed"
+		return result
+	}
+
+	value := receiver.clusterLabel(alert)
+	if value == "" {
+		result.Message = "alert does not have cluster label"
+		return result
+	}
+	result.Cluster = value
+	if receiver.Resolve != nil {
+		cluster, err := receiver.Resolve(value)
+		if err != nil {
+			result.Action = Failed
+			result.Message = err.Error()
+			return result
+		}
+		result.Cluster = cluster
+	}
+
+	if receiver.Duplicate != nil {
+		duplicate, message, err := receiver.Duplicate(result.Cluster, receiver.Now().Add(-receiver.DedupWindow))
+		if err != nil {
+			result.Action = Failed
+			result.Message = err.Error()
+			return result
+		}
+		result.Message = message
+		if duplicate {
+			return result
+		}
+	}
+
+	err := receiver.API.AddTrigger(receiver.Username, result.Cluster, alert.Summary(), alert.GeneratorURL)
+	if err != nil {
+		result.Action = Failed
+		result.Message = err.Error()
+		return result
+	}
+	result.Action = Created
+	return result
+}
+
+// authorized method checks bearer token sent with the request
+func (receiver *Receiver) authorized(request *http.Request) bool {
+	if receiver.Token == "" {
+		return true
+	}
+	expected := "Bearer " + receiver.Token
+	return subtle.ConstantTimeCompare([]byte(request.Header.Get("Authorization")), []byte(expected)) == 1
+}
+
+// Handle method processes all alerts from notification and returns results
+// in the same order as alerts
+func (receiver *Receiver) Handle(notification Notification) []Result {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	results := make([]Result, len(notification.Alerts))
+	for i, alert := range notification.Alerts {
+		results[i] = receiver.process(alert)
+		if receiver.Report != nil {
+			receiver.Report(results[i])
+		}
+	}
+	return results
+}
+
+// ServeHTTP method accepts webhook notification and responds with results
+// of all alerts. Status 500 is returned when any trigger can not be created,
+// so Alertmanager sends the notification again. Requests without the bearer
+// token are refused when the token is set.
+func (receiver *Receiver) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if !receiver.authorized(request) {
+		writer.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(writer, "invalid or missing bearer token", http.StatusUnauthorized)
+		return
+	}
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	notification, err := ReadNotification(http.MaxBytesReader(writer, request.Body, MaxNotificationSize))
+	if err != nil {
+		http.Error(writer, "invalid notification: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results := receiver.Handle(notification)
+	status := http.StatusOK
+	for _, result := range results {
+		if result.Action == Failed {
+			status = http.StatusInternalServerError
+		}
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	// nothing can be done when response can't be written
+	_ = json.NewEncoder(writer).Encode(results)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerts_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/alerts/receiver_test.html
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/alerts"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// triggerAPI is an implementation of REST API that records created triggers
+// and the time they were created at, triggers for cluster "unreachable" can
+// not be created
+type triggerAPI struct {
+	restapi.API
+	calls   *[]string
+	created map[string]time.Time
+	now     *time.Time
+}
+
+// AddTrigger records the call
+func (api triggerAPI) AddTrigger(username, clusterName, reason, link string) error {
+	if clusterName == "unreachable" {
+		return errors.New("cluster is not reachable")
+	}
+	*api.calls = append(*api.calls, strings.Join([]string{username, clusterName, reason, link}, " "))
+	api.created[clusterName] = *api.now
+	return nil
+}
+
+// duplicate method checks whether trigger for the cluster has been created
+// after the given time
+func (api triggerAPI) duplicate(cluster string, since time.Time) (bool, string, error) {
+	if created, found := api.created[cluster]; found && created.After(since) {
+		return true, "trigger has been created at " + created.Format(time.RFC3339), nil
+	}
+	return false, "", nil
+}
+
+// notification contains two firing alerts for one cluster, firing alert for
+// another cluster, resolved alert and alert without cluster label
+const notification = `{
+  "version": "4",
+  "status": "firing",
+  "receiver": "insights",
+  "alerts": [
+    {"status": "firing", "labels": {"alertname": "KubeAPIDown", "_id": "cluster-a"},
+     "annotations": {"summary": "API server is down"}, "generatorURL": "https://prometheus/graph?g0.expr=up"},
+    {"status": "firing", "labels": {"alertname": "KubeAPIErrorsHigh", "_id": "cluster-a"},
+     "annotations": {}, "generatorURL": "https://prometheus/graph?g0.expr=errors"},
+    {"status": "firing", "labels": {"alertname": "ClusterOperatorDown", "cluster_id": "cluster-b", "_id": "ignored"},
+     "annotations": {"summary": "operator is down"}, "generatorURL": "https://prometheus/graph"},
+    {"status": "resolved", "labels": {"alertname": "KubeAPIDown", "_id": "cluster-c"}},
+    {"status": "firing", "labels": {"alertname": "Watchdog"}}
+  ]
+}`
+
+// newReceiver is a helper function that constructs receiver with recording
+// API and fake clock
+func newReceiver(now *time.Time) (*alerts.Receiver, *[]string) {
+	api := triggerAPI{calls: &[]string{}, created: map[string]time.Time{}, now: now}
+	receiver := alerts.NewReceiver(api, "alertmanager")
+	receiver.Duplicate = api.duplicate
+	receiver.Now = func() time.Time {
+		return *now
+	}
+	return receiver, api.calls
+}
+
+// post is a helper function that sends notification to receiver and
+// returns response status and decoded results
+func post(t *testing.T, receiver *alerts.Receiver, body string) (int, []alerts.Result) {
+	return postWithToken(t, receiver, body, "")
+}
+
+// postWithToken is a helper function that sends notification with bearer
+// token to receiver and returns response status and decoded results
+func postWithToken(t *testing.T, receiver *alerts.Receiver, body, token string) (int, []alerts.Result) {
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var results []alerts.Result
+	if response.StatusCode == http.StatusOK || response.StatusCode == http.StatusInternalServerError {
+		if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return response.StatusCode, results
+}
+
+// actions is a helper function that returns actions of all results
+func actions(results []alerts.Result) string {
+	items := []string{}
+	for _, result := range results {
+		items = append(items, string(result.Action))
+	}
+	return strings.Join(items, ",")
+}
+
+// TestReceiverCreatesTriggers function checks that triggers are created for
+// firing alerts and that duplicates are suppressed.
+func TestReceiverCreatesTriggers(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+
+	status, results := post(t, receiver, notification)
+	if status != http.StatusOK {
+		t.Fatal("Unexpected status:", status)
+	}
+	if actions(results) != "created,skipped,created,skipped,skipped" {
+		t.Fatal("Unexpected results:", results)
+	}
+	expected := []string{
+		"alertmanager cluster-a API server is down https://prometheus/graph?g0.expr=up",
+		"alertmanager cluster-b operator is down https://prometheus/graph",
+	}
+	if strings.Join(*calls, "\n") != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected triggers:", *calls)
+	}
+	if results[1].Message != "trigger has been created at 2020-01-01T00:00:00Z" || results[4].Message != "alert does not have cluster label" {
+		t.Fatal("Unexpected messages:", results)
+	}
+
+	// the same notification sent again within deduplication window
+	now = now.Add(30 * time.Minute)
+	_, results = post(t, receiver, notification)
+	if actions(results) != "skipped,skipped,skipped,skipped,skipped" || len(*calls) != 2 {
+		t.Fatal("Unexpected results:", results)
+	}
+
+	// deduplication window elapsed
+	now = now.Add(30 * time.Minute)
+	_, results = post(t, receiver, notification)
+	if actions(results) != "created,skipped,created,skipped,skipped" || len(*calls) != 4 {
+		t.Fatal("Unexpected results:", results)
+	}
+}
+
+// TestReceiverAllowedAlerts function checks that only alerts from allowlist
+// are accepted.
+func TestReceiverAllowedAlerts(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+	receiver.AllowedAlerts = []string{"KubeAPIErrorsHigh"}
+
+	_, results := post(t, receiver, notification)
+	if actions(results) != "skipped,created,skipped,skipped,skipped" {
+		t.Fatal("Unexpected results:", results)
+	}
+	// alert name is used as reason when summary is not available
+	if len(*calls) != 1 || (*calls)[0] != "alertmanager cluster-a KubeAPIErrorsHigh https://prometheus/graph?g0.expr=errors" {
+		t.Fatal("Unexpected triggers:", *calls)
+	}
+}
+
+// TestReceiverResolveCluster function checks mapping of label values to
+// clusters.
+func TestReceiverResolveCluster(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+	receiver.ClusterLabels = []string{"_id"}
+	receiver.Resolve = func(value string) (string, error) {
+		if value == "ignored" {
+			return "", errors.New("unknown cluster")
+		}
+		return "resolved-" + value, nil
+	}
+
+	status, results := post(t, receiver, notification)
+	if status != http.StatusInternalServerError {
+		t.Fatal("Unexpected status:", status)
+	}
+	if actions(results) != "created,skipped,failed,skipped,skipped" || results[2].Message != "unknown cluster" {
+		t.Fatal("Unexpected results:", results)
+	}
+	if len(*calls) != 1 || !strings.HasPrefix((*calls)[0], "alertmanager resolved-cluster-a ") {
+		t.Fatal("Unexpected triggers:", *calls)
+	}
+}
+
+// TestReceiverFailure function checks that failed trigger is reported and
+// it is not considered to be a duplicate when the alert is sent again.
+func TestReceiverFailure(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, _ := newReceiver(&now)
+	reported := []alerts.Result{}
+	receiver.Report = func(result alerts.Result) {
+		reported = append(reported, result)
+	}
+
+	body := `{"alerts": [{"status": "firing", "labels": {"alertname": "KubeAPIDown", "_id": "unreachable"}}]}`
+	for i := 0; i < 2; i++ {
+		status, results := post(t, receiver, body)
+		if status != http.StatusInternalServerError || actions(results) != "failed" {
+			t.Fatal("Unexpected results:", status, results)
+		}
+	}
+	if len(reported) != 2 || reported[0].Message != "cluster is not reachable" {
+		t.Fatal("Unexpected reported results:", reported)
+	}
+}
+
+// TestReceiverInvalidRequests function checks that invalid requests are
+// refused.
+func TestReceiverInvalidRequests(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+
+	tooLarge := `{"alerts": [], "receiver": "` + strings.Repeat("x", alerts.MaxNotificationSize) + `"}`
+	for i, body := range []string{"", "{", `{"status": "firing"}`, tooLarge} {
+		status, _ := post(t, receiver, body)
+		if status != http.StatusBadRequest {
+			t.Fatal("Unexpected status for body", i, status)
+		}
+	}
+
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+	response, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatal("Unexpected status:", response.StatusCode)
+	}
+	if len(*calls) != 0 {
+		t.Fatal("No trigger should be created:", *calls)
+	}
+}
+
+// TestReceiverDuplicateCheck function checks that message from duplicate
+// check is reported and that alert fails when the check can't be done.
+func TestReceiverDuplicateCheck(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+	receiver.Duplicate = func(cluster string, since time.Time) (bool, string, error) {
+		if since != now.Add(-alerts.DefaultDedupWindow) {
+			t.Fatal("Unexpected start of deduplication window:", since)
+		}
+		if cluster == "cluster-b" {
+			return false, "", errors.New("list of triggers can not be read")
+		}
+		return false, "trigger 1 has not been acknowledged yet", nil
+	}
+
+	status, results := post(t, receiver, notification)
+	if status != http.StatusInternalServerError {
+		t.Fatal("Unexpected status:", status)
+	}
+	if actions(results) != "created,created,failed,skipped,skipped" || len(*calls) != 2 {
+		t.Fatal("Unexpected results:", results)
+	}
+	if results[0].Message != "trigger 1 has not been acknowledged yet" || results[2].Message != "list of triggers can not be read" {
+		t.Fatal("Unexpected messages:", results)
+	}
+}
+
+// TestReceiverToken function checks that requests without proper bearer
+// token are refused when the token is set.
+func TestReceiverToken(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	receiver, calls := newReceiver(&now)
+	receiver.Token = "secret"
+
+	for _, token := range []string{"", "wrong", "secret2"} {
+		status, _ := postWithToken(t, receiver, notification, token)
+		if status != http.StatusUnauthorized {
+			t.Fatal("Unexpected status for token", token, status)
+		}
+	}
+	if len(*calls) != 0 {
+		t.Fatal("No trigger should be created:", *calls)
+	}
+
+	status, results := postWithToken(t, receiver, notification, "secret")
+	if status != http.StatusOK || actions(results) != "created,skipped,created,skipped,skipped" {
+		t.Fatal("Unexpected results:", status, results)
+	}
+}
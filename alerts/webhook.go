@@ -0,0 +1,110 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package alerts contains implementation of receiver of Alertmanager webhook
+// notifications. Firing alerts are mapped to clusters by their labels and a
+// must-gather trigger is created for each such cluster, with the alert
+// summary used as reason and the alert generator URL used as link.
+// Duplicate triggers are suppressed by a deduplication window and only
+// alerts with selected names can be accepted.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * receiver.go
+//
+// * webhook.go
+package alerts
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/alerts
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/alerts/webhook.html
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+)
+
+// status of firing alerts
+const statusFiring = "firing"
+
+// labels and annotations used by receiver
+const (
+	alertNameLabel    = "alertname"
+	summaryAnnotation = "summary"
+)
+
+// Alert represents one alert sent by Alertmanager
+type Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Name method returns name of alert
+func (alert Alert) Name() string {
+	return alert.Labels[alertNameLabel]
+}
+
+// Summary method returns summary of alert, alert name is used when summary
+// is not specified
+func (alert Alert) Summary() string {
+	if summary := alert.Annotations[summaryAnnotation]; summary != "" {
+		return summary
+	}
+	return alert.Name()
+}
+
+// Firing method checks whether the alert is firing, i.e. not resolved
+func (alert Alert) Firing() bool {
+	return alert.Status == statusFiring
+}
+
+// Notification represents webhook notification sent by Alertmanager, it
+// contains group of alerts
+type Notification struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// ReadNotification function decodes webhook notification sent by
+// Alertmanager
+func ReadNotification(reader io.Reader) (Notification, error) {
+	var notification Notification
+	err := json.NewDecoder(reader).Decode(&notification)
+	if err != nil {
+		return notification, err
+	}
+	if notification.Alerts == nil {
+		return notification, errors.New("notification does not contain any alert")
+	}
+	return notification, nil
+}
@@ -0,0 +1,268 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/audit
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/audit/api.html
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// names of audited operations
+const (
+	AddCluster                 = "add cluster"
+	DeleteCluster              = "delete cluster"
+	AddConfigurationProfile    = "add profile"
+	UpdateConfigurationProfile = "update profile"
+	DeleteConfigurationProfile = "delete profile"
+	AddClusterConfiguration    = "add configuration"
+	EnableConfiguration        = "enable configuration"
+	DisableConfiguration       = "disable configuration"
+	DeleteConfiguration        = "delete configuration"
+	AddTrigger                 = "add trigger"
+	DeleteTrigger              = "delete trigger"
+	ActivateTrigger            = "activate trigger"
+	DeactivateTrigger          = "deactivate trigger"
+)
+
+// Context represents context in which operations are performed
+type Context struct {
+	OSUser     string
+	Username   string
+	Controller string
+	Command    string
+}
+
+// API is an implementation of API interface that calls another (usually
+// REST API) implementation and records all mutating operations into audit
+// log. All read operations are just passed to the wrapped implementation.
+type API struct {
+	api     restapi.API
+	log     *Log
+	context func() Context
+	now     func() time.Time
+}
+
+// NewAPI function is a constructor to construct new instance of auditing
+// API. The context function is called for each recorded operation, because
+// the logged in user and the command line change during the session.
+func NewAPI(api restapi.API, log *Log, context func() Context) API {
+	return API{
+		api:     api,
+		log:     log,
+		context: context,
+		now:     time.Now,
+	}
+}
+
+// digest function returns size and SHA-256 digest of document, whole
+// documents are not recorded in audit log
+func digest(document []byte) string {
+	return fmt.Sprintf("%d bytes, sha256:%x", len(document), sha256.Sum256(document))
+}
+
+// record method appends record of operation to audit log and returns the
+// result of operation. Inability to record the operation is not fatal, the
+// operation has already been performed.
+func (api API) record(operation string, arguments map[string]string, result error) error {
+	context := api.context()
+	record := Record{
+		Timestamp:  api.now().UTC(),
+		OSUser:     context.OSUser,
+		Username:   context.Username,
+		Controller: context.Controller,
+		Command:    context.Command,
+		Operation:  operation,
+		Arguments:  arguments,
+		Result:     ResultOK,
+	}
+	if result != nil {
+		record.Result = ResultError
+		record.Error = result.Error()
+	}
+
+	err := api.log.Append(record)
+	if err != nil {
+		log.Println(err)
+	}
+	return result
+}
+
+// ReadListOfClusters method reads list of clusters
+func (api API) ReadListOfClusters() ([]types.Cluster, error) {
+	return api.api.ReadListOfClusters()
+}
+
+// AddCluster method adds/registers new cluster
+func (api API) AddCluster(name string) error {
+	return api.record(AddCluster, map[string]string{"name": name},
+		api.api.AddCluster(name))
+}
+
+// DeleteCluster method deletes/deregisters existing cluster
+func (api API) DeleteCluster(clusterID string) error {
+	return api.record(DeleteCluster, map[string]string{"id": clusterID},
+		api.api.DeleteCluster(clusterID))
+}
+
+// ReadListOfConfigurationProfiles method reads list of configuration profiles
+func (api API) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	return api.api.ReadListOfConfigurationProfiles()
+}
+
+// ReadConfigurationProfile method reads selected configuration profile
+func (api API) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	return api.api.ReadConfigurationProfile(profileID)
+}
+
+// AddConfigurationProfile method adds new configuration profile
+func (api API) AddConfigurationProfile(username, description string, configuration []byte) error {
+	arguments := map[string]string{
+		"username":      username,
+		"description":   description,
+		"configuration": digest(configuration),
+	}
+	return api.record(AddConfigurationProfile, arguments,
+		api.api.AddConfigurationProfile(username, description, configuration))
+}
+
+// UpdateConfigurationProfile method updates existing configuration profile
+func (api API) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	arguments := map[string]string{
+		"id":            profileID,
+		"username":      username,
+		"description":   description,
+		"configuration": digest(configuration),
+	}
+	return api.record(UpdateConfigurationProfile, arguments,
+		api.api.UpdateConfigurationProfile(profileID, username, description, configuration))
+}
+
+// DeleteConfigurationProfile method deletes existing configuration profile
+func (api API) DeleteConfigurationProfile(profileID string) error {
+	return api.record(DeleteConfigurationProfile, map[string]string{"id": profileID},
+		api.api.DeleteConfigurationProfile(profileID))
+}
+
+// ReadListOfConfigurations method reads list of cluster configurations
+func (api API) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	return api.api.ReadListOfConfigurations()
+}
+
+// ReadClusterConfigurationByID method reads cluster configuration identified
+// by its ID
+func (api API) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	return api.api.ReadClusterConfigurationByID(configurationID)
+}
+
+// AddClusterConfiguration method adds new cluster configuration
+func (api API) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	arguments := map[string]string{
+		"username":      username,
+		"cluster":       cluster,
+		"reason":        reason,
+		"description":   description,
+		"configuration": digest(configuration),
+	}
+	return api.record(AddClusterConfiguration, arguments,
+		api.api.AddClusterConfiguration(username, cluster, reason, description, configuration))
+}
+
+// EnableClusterConfiguration method enables existing cluster configuration
+func (api API) EnableClusterConfiguration(configurationID string) error {
+	return api.record(EnableConfiguration, map[string]string{"id": configurationID},
+		api.api.EnableClusterConfiguration(configurationID))
+}
+
+// DisableClusterConfiguration method disables existing cluster configuration
+func (api API) DisableClusterConfiguration(configurationID string) error {
+	return api.record(DisableConfiguration, map[string]string{"id": configurationID},
+		api.api.DisableClusterConfiguration(configurationID))
+}
+
+// DeleteClusterConfiguration method deletes existing cluster configuration
+func (api API) DeleteClusterConfiguration(configurationID string) error {
+	return api.record(DeleteConfiguration, map[string]string{"id": configurationID},
+		api.api.DeleteClusterConfiguration(configurationID))
+}
+
+// ReadListOfTriggers method reads list of triggers
+func (api API) ReadListOfTriggers() ([]types.Trigger, error) {
+	return api.api.ReadListOfTriggers()
+}
+
+// ReadTriggerByID method reads trigger identified by its ID
+func (api API) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	return api.api.ReadTriggerByID(triggerID)
+}
+
+// AddTrigger method adds new must-gather trigger
+func (api API) AddTrigger(username, clusterName, reason, link string) error {
+	arguments := map[string]string{
+		"username": username,
+		"cluster":  clusterName,
+		"type":     "must-gather",
+		"reason":   reason,
+		"link":     link,
+	}
+	return api.record(AddTrigger, arguments,
+		api.api.AddTrigger(username, clusterName, reason, link))
+}
+
+// AddTypedTrigger method adds new trigger of given type
+func (api API) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	arguments := map[string]string{
+		"username": username,
+		"cluster":  clusterName,
+		"type":     triggerType,
+		"reason":   reason,
+		"link":     link,
+	}
+	if parameters != nil {
+		arguments["parameters"] = string(parameters)
+	}
+	return api.record(AddTrigger, arguments,
+		api.api.AddTypedTrigger(username, clusterName, triggerType, reason, link, parameters))
+}
+
+// DeleteTrigger method deletes existing trigger
+func (api API) DeleteTrigger(triggerID string) error {
+	return api.record(DeleteTrigger, map[string]string{"id": triggerID},
+		api.api.DeleteTrigger(triggerID))
+}
+
+// ActivateTrigger method activates existing trigger
+func (api API) ActivateTrigger(triggerID string) error {
+	return api.record(ActivateTrigger, map[string]string{"id": triggerID},
+		api.api.ActivateTrigger(triggerID))
+}
+
+// DeactivateTrigger method deactivates existing trigger
+func (api API) DeactivateTrigger(triggerID string) error {
+	return api.record(DeactivateTrigger, map[string]string{"id": triggerID},
+		api.api.DeactivateTrigger(triggerID))
+}
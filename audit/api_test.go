@@ -0,0 +1,194 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/audit/api_test.html
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/audit"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// controllerAPI is an implementation of REST API where all mutating
+// operations succeed except of operations with ID "13"
+type controllerAPI struct {
+	restapi.API
+}
+
+// result function returns result of operation with given ID
+func result(id string) error {
+	if id == "13" {
+		return errors.New("object not found")
+	}
+	return nil
+}
+
+// methods of REST API used by tests
+func (controllerAPI) ReadListOfTriggers() ([]types.Trigger, error) {
+	return []types.Trigger{{ID: 1}}, nil
+}
+func (controllerAPI) AddCluster(name string) error  { return nil }
+func (controllerAPI) DeleteCluster(id string) error { return result(id) }
+func (controllerAPI) AddConfigurationProfile(username, description string, configuration []byte) error {
+	return nil
+}
+func (controllerAPI) UpdateConfigurationProfile(id, username, description string, configuration []byte) error {
+	return result(id)
+}
+func (controllerAPI) DeleteConfigurationProfile(id string) error { return result(id) }
+func (controllerAPI) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	return nil
+}
+func (controllerAPI) EnableClusterConfiguration(id string) error              { return result(id) }
+func (controllerAPI) DisableClusterConfiguration(id string) error             { return result(id) }
+func (controllerAPI) DeleteClusterConfiguration(id string) error              { return result(id) }
+func (controllerAPI) AddTrigger(username, cluster, reason, link string) error { return nil }
+func (controllerAPI) AddTypedTrigger(username, cluster, triggerType, reason, link string, parameters []byte) error {
+	return nil
+}
+func (controllerAPI) DeleteTrigger(id string) error     { return result(id) }
+func (controllerAPI) ActivateTrigger(id string) error   { return result(id) }
+func (controllerAPI) DeactivateTrigger(id string) error { return result(id) }
+
+// newAuditingAPI is a helper function that constructs auditing API writing
+// into temporary log
+func newAuditingAPI(t *testing.T, command *string) (audit.API, *audit.Log) {
+	log := audit.NewLog(filepath.Join(t.TempDir(), "audit", "audit.jsonl"))
+	api := audit.NewAPI(controllerAPI{}, log, func() audit.Context {
+		return audit.Context{OSUser: "root", Username: "tester", Controller: "http://localhost:8080", Command: *command}
+	})
+	return api, log
+}
+
+// TestAuditMutatingOperations function checks that all mutating operations
+// are recorded.
+func TestAuditMutatingOperations(t *testing.T) {
+	command := "apply -f manifest.yaml"
+	api, log := newAuditingAPI(t, &command)
+
+	calls := []error{
+		api.AddCluster("cluster-a"),
+		api.DeleteCluster("1"),
+		api.AddConfigurationProfile("tester", "profile", []byte("{}")),
+		api.UpdateConfigurationProfile("2", "tester", "profile", []byte("{}")),
+		api.DeleteConfigurationProfile("2"),
+		api.AddClusterConfiguration("tester", "cluster-a", "reason", "description", []byte("{}")),
+		api.EnableClusterConfiguration("3"),
+		api.DisableClusterConfiguration("3"),
+		api.DeleteClusterConfiguration("3"),
+		api.AddTrigger("tester", "cluster-a", "reason", "link"),
+		api.AddTypedTrigger("tester", "cluster-a", "insights-gather", "reason", "link", []byte(`{"upload":true}`)),
+		api.DeleteTrigger("4"),
+		api.ActivateTrigger("4"),
+		api.DeactivateTrigger("4"),
+	}
+	for _, err := range calls {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// read operations are not recorded
+	if _, err := api.ReadListOfTriggers(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := log.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := []string{}
+	for _, record := range records {
+		operations = append(operations, record.Operation)
+	}
+	expected := []string{
+		audit.AddCluster, audit.DeleteCluster, audit.AddConfigurationProfile, audit.UpdateConfigurationProfile,
+		audit.DeleteConfigurationProfile, audit.AddClusterConfiguration, audit.EnableConfiguration,
+		audit.DisableConfiguration, audit.DeleteConfiguration, audit.AddTrigger, audit.AddTrigger,
+		audit.DeleteTrigger, audit.ActivateTrigger, audit.DeactivateTrigger,
+	}
+	if strings.Join(operations, ",") != strings.Join(expected, ",") {
+		t.Fatal("Unexpected operations:", operations)
+	}
+
+	record := records[6]
+	if record.OSUser != "root" || record.Username != "tester" || record.Controller != "http://localhost:8080" ||
+		record.Command != command || record.Result != audit.ResultOK || record.Error != "" ||
+		record.FormatArguments() != `id="3"` || time.Since(record.Timestamp) > time.Minute {
+		t.Fatal("Unexpected record:", record)
+	}
+	if records[2].Arguments["configuration"] != "2 bytes, sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a" {
+		t.Fatal("Unexpected digest of configuration:", records[2].Arguments)
+	}
+	if records[10].Arguments["parameters"] != `{"upload":true}` || records[10].Arguments["type"] != "insights-gather" {
+		t.Fatal("Unexpected arguments:", records[10].Arguments)
+	}
+}
+
+// TestAuditFailedOperation function checks that failed operation is
+// recorded and its error is returned.
+func TestAuditFailedOperation(t *testing.T) {
+	command := "disable configuration 13"
+	api, log := newAuditingAPI(t, &command)
+
+	err := api.DisableClusterConfiguration("13")
+	if err == nil || err.Error() != "object not found" {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	records, err := log.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Result != audit.ResultError || records[0].Error != "object not found" {
+		t.Fatal("Unexpected records:", records)
+	}
+}
+
+// TestAuditConcurrentOperations function checks that operations performed
+// concurrently are recorded on separate lines.
+func TestAuditConcurrentOperations(t *testing.T) {
+	command := "delete trigger 1-50"
+	api, log := newAuditingAPI(t, &command)
+
+	var wait sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			_ = api.DeleteTrigger("1")
+		}()
+	}
+	wait.Wait()
+
+	records, err := log.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 50 {
+		t.Fatal("Unexpected number of records:", len(records))
+	}
+}
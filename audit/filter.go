@@ -0,0 +1,72 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/audit
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/audit/filter.html
+
+import "time"
+
+// Filter represents conditions that need to be met by selected records,
+// empty values match all records
+type Filter struct {
+	Username   string
+	OSUser     string
+	Operation  string
+	ID         string
+	Cluster    string
+	Since      time.Time
+	Until      time.Time
+	FailedOnly bool
+}
+
+// Matches method checks whether the record meets all conditions
+func (filter Filter) Matches(record Record) bool {
+	switch {
+	case filter.Username != "" && record.Username != filter.Username:
+		return false
+	case filter.OSUser != "" && record.OSUser != filter.OSUser:
+		return false
+	case filter.Operation != "" && record.Operation != filter.Operation:
+		return false
+	case filter.ID != "" && record.Arguments["id"] != filter.ID:
+		return false
+	case filter.Cluster != "" && record.Arguments["cluster"] != filter.Cluster:
+		return false
+	case !filter.Since.IsZero() && record.Timestamp.Before(filter.Since):
+		return false
+	case !filter.Until.IsZero() && !record.Timestamp.Before(filter.Until):
+		return false
+	case filter.FailedOnly && record.Result != ResultError:
+		return false
+	}
+	return true
+}
+
+// Select function returns records that match the filter
+func Select(records []Record, filter Filter) []Record {
+	selected := []Record{}
+	for _, record := range records {
+		if filter.Matches(record) {
+			selected = append(selected, record)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit contains implementation of local audit log. Every call of a
+// mutating REST API method is recorded as one line of JSON document together
+// with the OS user, logged in user, controller URL and command line, so it is
+// possible to find out later who changed the controller state, when and from
+// which host.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * api.go
+//
+// * filter.go
+//
+// * log.go
+package audit
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/audit
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/audit/log.html
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// results of audited operations
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Record represents one audited operation
+type Record struct {
+	Timestamp  time.Time         `json:"timestamp"`
+	OSUser     string            `json:"os_user"`
+	Username   string            `json:"username"`
+	Controller string            `json:"controller"`
+	Command    string            `json:"command"`
+	Operation  string            `json:"operation"`
+	Arguments  map[string]string `json:"arguments"`
+	Result     string            `json:"result"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// FormatArguments method returns arguments of operation in key=value form
+// sorted by keys
+func (record Record) FormatArguments() string {
+	keys := make([]string, 0, len(record.Arguments))
+	for key := range record.Arguments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%q", key, record.Arguments[key])
+	}
+	return strings.Join(pairs, " ")
+}
+
+// Log represents audit log stored in a local file in JSON lines format
+type Log struct {
+	fileName string
+	mutex    sync.Mutex
+}
+
+// DefaultLogFile function returns path to the file used to store audit log
+// when no other file is specified in configuration.
+func DefaultLogFile() (string, error) {
+	directory, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(directory, "insights-operator-cli", "audit.jsonl"), nil
+}
+
+// NewLog function is a constructor to construct new instance of audit log.
+// The file does not need to exist, it is created when the first record is
+// appended.
+func NewLog(fileName string) *Log {
+	return &Log{fileName: fileName}
+}
+
+// Append method appends the record at the end of log
+func (log *Log) Append(record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+
+	err = os.MkdirAll(filepath.Dir(log.fileName), 0o700)
+	if err != nil {
+		return err
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	file, err := os.OpenFile(log.fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) // #nosec G304
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Read method reads all records from log in the order they were appended.
+// Log that does not exist yet is considered to be empty.
+func (log *Log) Read() ([]Record, error) {
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	file, err := os.Open(log.fileName) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) {
+		return []Record{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records := []Record{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", log.fileName, line, err)
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
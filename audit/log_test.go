@@ -0,0 +1,115 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/audit/log_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/audit"
+)
+
+// records used by tests
+var records = []audit.Record{
+	{Timestamp: time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC), OSUser: "alice", Username: "alice",
+		Operation: audit.DisableConfiguration, Arguments: map[string]string{"id": "12"}, Result: audit.ResultOK},
+	{Timestamp: time.Date(2020, 1, 2, 10, 0, 0, 0, time.UTC), OSUser: "root", Username: "bob",
+		Operation: audit.AddTrigger, Arguments: map[string]string{"cluster": "cluster-a"}, Result: audit.ResultError, Error: "timeout"},
+	{Timestamp: time.Date(2020, 1, 3, 10, 0, 0, 0, time.UTC), OSUser: "root", Username: "alice",
+		Operation: audit.EnableConfiguration, Arguments: map[string]string{"id": "12"}, Result: audit.ResultOK},
+}
+
+// TestLogAppendRead function checks that appended records can be read.
+func TestLogAppendRead(t *testing.T) {
+	log := audit.NewLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	// log that does not exist is empty
+	read, err := log.Read()
+	if err != nil || len(read) != 0 {
+		t.Fatal("Unexpected records:", read, err)
+	}
+
+	for _, record := range records {
+		if err := log.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read, err = log.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(records) || !read[1].Timestamp.Equal(records[1].Timestamp) ||
+		read[1].Error != "timeout" || read[2].Arguments["id"] != "12" {
+		t.Fatal("Unexpected records:", read)
+	}
+}
+
+// TestLogInvalidRecord function checks that invalid line is reported.
+func TestLogInvalidRecord(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "audit.jsonl")
+	err := os.WriteFile(fileName, []byte("{}\n\n{\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = audit.NewLog(fileName).Read()
+	if err == nil || !strings.Contains(err.Error(), "audit.jsonl:3:") {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+// TestSelect function checks filtering of records.
+func TestSelect(t *testing.T) {
+	cases := []struct {
+		filter   audit.Filter
+		expected []int
+	}{
+		{audit.Filter{ID: "12"}, []int{0, 2}},
+		{audit.Filter{FailedOnly: true}, []int{1}},
+		{audit.Filter{OSUser: "root"}, []int{1, 2}},
+		{audit.Filter{Username: "alice"}, []int{0, 2}},
+		{audit.Filter{Operation: audit.EnableConfiguration}, []int{2}},
+		{audit.Filter{Cluster: "cluster-a"}, []int{1}},
+		{audit.Filter{Since: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}, []int{1, 2}},
+		{audit.Filter{Until: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}, []int{0}},
+		{audit.Filter{Username: "alice", OSUser: "alice", ID: "13"}, []int{}},
+	}
+	for _, c := range cases {
+		selected := audit.Select(records, c.filter)
+		if len(selected) != len(c.expected) {
+			t.Fatal("Unexpected records for", c.filter, selected)
+		}
+		for i, index := range c.expected {
+			if !selected[i].Timestamp.Equal(records[index].Timestamp) {
+				t.Fatal("Unexpected records for", c.filter, selected)
+			}
+		}
+	}
+}
+
+// TestFormatArguments function checks formatting of operation arguments.
+func TestFormatArguments(t *testing.T) {
+	record := audit.Record{Arguments: map[string]string{"reason": "upgrade \"stuck\"", "cluster": "cluster-a"}}
+	if record.FormatArguments() != `cluster="cluster-a" reason="upgrade \"stuck\""` {
+		t.Fatal("Unexpected arguments:", record.FormatArguments())
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/backup
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/archive.html
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxFileSize is the maximum size of one file read from archive, it protects
+// the client against decompression bombs
+const maxFileSize = 256 * 1024 * 1024
+
+// dataFile represents one data file stored in archive
+type dataFile struct {
+	name  string
+	value interface{}
+}
+
+// dataFiles function returns all data files for given snapshot in the order
+// in which they are stored into archive
+func dataFiles(snapshot *Snapshot) []dataFile {
+	return []dataFile{
+		{clustersFile, &snapshot.Clusters},
+		{profilesFile, &snapshot.Profiles},
+		{configurationsFile, &snapshot.Configurations},
+		{triggersFile, &snapshot.Triggers},
+	}
+}
+
+// checksum function returns SHA-256 checksum of content in hex format
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeTarFile function writes one file into tar archive
+func writeTarFile(archive *tar.Writer, name string, content []byte, modTime time.Time) error {
+	header := tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	}
+	err := archive.WriteHeader(&header)
+	if err != nil {
+		return err
+	}
+	_, err = archive.Write(content)
+	return err
+}
+
+// Write function stores the snapshot into gzipped tarball. Manifest with
+// checksums of all data files is stored as the first file.
+func Write(writer io.Writer, snapshot *Snapshot, createdAt time.Time) error {
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     createdAt.UTC().Format(time.RFC3339),
+		Counts:        snapshot.Counts(),
+	}
+
+	contents := map[string][]byte{}
+	for _, file := range dataFiles(snapshot) {
+		content, err := json.MarshalIndent(file.value, "", "    ")
+		if err != nil {
+			return err
+		}
+		contents[file.name] = content
+		manifest.Files = append(manifest.Files, ManifestFile{
+			Name:   file.name,
+			Size:   len(content),
+			SHA256: checksum(content),
+		})
+	}
+
+	manifestContent, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	compressed := gzip.NewWriter(writer)
+	archive := tar.NewWriter(compressed)
+
+	err = writeTarFile(archive, manifestFile, manifestContent, createdAt)
+	for _, file := range manifest.Files {
+		if err != nil {
+			break
+		}
+		err = writeTarFile(archive, file.Name, contents[file.Name], createdAt)
+	}
+
+	// both writers need to be closed to flush all data
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := compressed.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// readTarFiles function reads all regular files from gzipped tarball
+func readTarFiles(reader io.Reader) (map[string][]byte, error) {
+	compressed, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = compressed.Close()
+	}()
+
+	files := map[string][]byte{}
+	archive := tar.NewReader(compressed)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(io.LimitReader(archive, maxFileSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(content) > maxFileSize {
+			return nil, fmt.Errorf("file %s is too large", header.Name)
+		}
+		files[header.Name] = content
+	}
+}
+
+// Read function reads the snapshot from gzipped tarball. Version of archive
+// format and checksums of all files are checked.
+func Read(reader io.Reader) (*Snapshot, *Manifest, error) {
+	files, err := readTarFiles(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read archive: %v", err)
+	}
+
+	manifestContent, found := files[manifestFile]
+	if !found {
+		return nil, nil, errors.New("archive does not contain manifest")
+	}
+	var manifest Manifest
+	err = json.Unmarshal(manifestContent, &manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read manifest: %v", err)
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > FormatVersion {
+		return nil, nil, fmt.Errorf("unsupported archive format version %d", manifest.FormatVersion)
+	}
+
+	checksums := map[string]string{}
+	for _, file := range manifest.Files {
+		checksums[file.Name] = file.SHA256
+	}
+
+	var snapshot Snapshot
+	for _, file := range dataFiles(&snapshot) {
+		content, found := files[file.name]
+		if !found {
+			return nil, nil, fmt.Errorf("archive does not contain file %s", file.name)
+		}
+		if checksums[file.name] != checksum(content) {
+			return nil, nil, fmt.Errorf("checksum of file %s does not match", file.name)
+		}
+		err = json.Unmarshal(content, file.value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read file %s: %v", file.name, err)
+		}
+	}
+
+	return &snapshot, &manifest, nil
+}
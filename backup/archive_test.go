@@ -0,0 +1,211 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/archive_test.html
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/backup"
+)
+
+// sampleAPI function constructs in-memory REST API with few objects of each
+// kind
+func sampleAPI(t *testing.T) *memoryAPI {
+	api := newMemoryAPI(1)
+	for _, name := range []string{"cluster-a", "cluster-b"} {
+		if err := api.AddCluster(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := api.AddConfigurationProfile("tester", "default profile", []byte(`{"no_op":"X"}`)); err != nil {
+		t.Fatal(err)
+	}
+	for _, payload := range []string{`{"watch":[]}`, `{"watch":["a"]}`} {
+		if err := api.AddClusterConfiguration("tester", "cluster-a", "reason", "desc", []byte(payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the first configuration is not active anymore
+	if err := api.DisableClusterConfiguration("5"); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.AddTrigger("tester", "cluster-b", "because", "https://example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.AddTrigger("tester", "cluster-a", "old", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.DeactivateTrigger("9"); err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+// writeArchive function creates archive with snapshot of given API
+func writeArchive(t *testing.T, api *memoryAPI) (*backup.Snapshot, []byte) {
+	snapshot, err := backup.Collect(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	err = backup.Write(&buffer, snapshot, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snapshot, buffer.Bytes()
+}
+
+// rewriteArchive function creates copy of archive where content of files is
+// changed by the given function
+func rewriteArchive(t *testing.T, archive []byte, change func(name string, content []byte) []byte) []byte {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content = change(header.Name, content)
+		header.Size = int64(len(content))
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+// TestCollect checks that payloads of profiles and configurations are part
+// of the snapshot
+func TestCollect(t *testing.T) {
+	snapshot, err := backup.Collect(sampleAPI(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]int{
+		backup.ClusterKind:       2,
+		backup.ProfileKind:       3,
+		backup.ConfigurationKind: 2,
+		backup.TriggerKind:       2,
+	}
+	if !reflect.DeepEqual(snapshot.Counts(), expected) {
+		t.Fatal("Unexpected counts", snapshot.Counts())
+	}
+	if snapshot.Profiles[0].Configuration != `{"no_op":"X"}` {
+		t.Fatal("Payload of profile is not collected", snapshot.Profiles[0])
+	}
+	if snapshot.Configurations[1].Payload != `{"watch":["a"]}` {
+		t.Fatal("Payload of configuration is not collected", snapshot.Configurations[1])
+	}
+}
+
+// TestArchiveRoundTrip checks that snapshot read from archive is the same as
+// the stored one
+func TestArchiveRoundTrip(t *testing.T) {
+	snapshot, archive := writeArchive(t, sampleAPI(t))
+
+	restored, manifest, err := backup.Read(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored, snapshot) {
+		t.Fatal("Snapshot read from archive differs", restored, snapshot)
+	}
+	if manifest.FormatVersion != backup.FormatVersion {
+		t.Fatal("Unexpected format version", manifest.FormatVersion)
+	}
+	if manifest.CreatedAt != "2026-10-01T12:00:00Z" {
+		t.Fatal("Unexpected creation time", manifest.CreatedAt)
+	}
+	if len(manifest.Files) != 4 {
+		t.Fatal("Checksums of all data files are expected", manifest.Files)
+	}
+}
+
+// TestArchiveChecksumMismatch checks that modified archive is refused
+func TestArchiveChecksumMismatch(t *testing.T) {
+	_, archive := writeArchive(t, sampleAPI(t))
+	tampered := rewriteArchive(t, archive, func(name string, content []byte) []byte {
+		if name == "clusters.json" {
+			return bytes.Replace(content, []byte("cluster-a"), []byte("cluster-x"), 1)
+		}
+		return content
+	})
+
+	_, _, err := backup.Read(bytes.NewReader(tampered))
+	if err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Fatal("Checksum error is expected", err)
+	}
+}
+
+// TestArchiveUnsupportedVersion checks that archive with unknown format
+// version is refused
+func TestArchiveUnsupportedVersion(t *testing.T) {
+	_, archive := writeArchive(t, sampleAPI(t))
+	changed := rewriteArchive(t, archive, func(name string, content []byte) []byte {
+		if name == "manifest.json" {
+			return bytes.Replace(content, []byte(`"format_version": 1`), []byte(`"format_version": 42`), 1)
+		}
+		return content
+	})
+
+	_, _, err := backup.Read(bytes.NewReader(changed))
+	if err == nil || !strings.Contains(err.Error(), "version") {
+		t.Fatal("Version error is expected", err)
+	}
+}
+
+// TestArchiveNotGzip checks that other files are refused
+func TestArchiveNotGzip(t *testing.T) {
+	_, _, err := backup.Read(strings.NewReader("this is not an archive"))
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backup contains implementation of export and import of the whole
+// controller state. All clusters, configuration profiles, cluster
+// configurations and triggers are read via REST API and stored into
+// versioned archive (gzipped tarball) with manifest containing checksums of
+// all files. The archive can be replayed into another controller later.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * archive.go
+//
+// * backup.go
+//
+// * collect.go
+//
+// * restore.go
+package backup
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/backup
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/backup.html
+
+import (
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// FormatVersion is version of archive format written by this package. Newer
+// archives can't be read.
+const FormatVersion = 1
+
+// names of files stored in archive
+const (
+	manifestFile       = "manifest.json"
+	clustersFile       = "clusters.json"
+	profilesFile       = "profiles.json"
+	configurationsFile = "configurations.json"
+	triggersFile       = "triggers.json"
+)
+
+// kinds of objects stored in archive, used in reports
+const (
+	ClusterKind       = "cluster"
+	ProfileKind       = "profile"
+	ConfigurationKind = "configuration"
+	TriggerKind       = "trigger"
+)
+
+// ConfigurationRecord represents cluster configuration together with its
+// payload
+type ConfigurationRecord struct {
+	types.ClusterConfiguration
+	Payload string `json:"payload"`
+}
+
+// Snapshot represents the whole state of controller service
+type Snapshot struct {
+	Clusters       []types.Cluster              `json:"clusters"`
+	Profiles       []types.ConfigurationProfile `json:"profiles"`
+	Configurations []ConfigurationRecord        `json:"configurations"`
+	Triggers       []types.Trigger              `json:"triggers"`
+}
+
+// Counts function returns number of objects of each kind in the snapshot
+func (snapshot *Snapshot) Counts() map[string]int {
+	return map[string]int{
+		ClusterKind:       len(snapshot.Clusters),
+		ProfileKind:       len(snapshot.Profiles),
+		ConfigurationKind: len(snapshot.Configurations),
+		TriggerKind:       len(snapshot.Triggers),
+	}
+}
+
+// ManifestFile represents one file stored in archive
+type ManifestFile struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest describes content of archive
+type Manifest struct {
+	FormatVersion int            `json:"format_version"`
+	CreatedAt     string         `json:"created_at"`
+	Counts        map[string]int `json:"counts"`
+	Files         []ManifestFile `json:"files"`
+}
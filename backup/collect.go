@@ -0,0 +1,91 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/backup
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/collect.html
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// collectProfiles function reads all configuration profiles together with
+// their payloads
+func collectProfiles(api restapi.API) ([]types.ConfigurationProfile, error) {
+	profiles, err := api.ReadListOfConfigurationProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read list of configuration profiles: %v", err)
+	}
+	result := []types.ConfigurationProfile{}
+	for _, profile := range profiles {
+		detail, err := api.ReadConfigurationProfile(strconv.Itoa(profile.ID))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read configuration profile %d: %v", profile.ID, err)
+		}
+		profile.Configuration = detail.Configuration
+		result = append(result, profile)
+	}
+	return result, nil
+}
+
+// Collect function reads the whole state of controller service via REST
+// API. Payloads of profiles and configurations are read one by one, because
+// they might not be part of lists.
+func Collect(api restapi.API) (*Snapshot, error) {
+	var snapshot Snapshot
+	var err error
+
+	snapshot.Clusters, err = api.ReadListOfClusters()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read list of clusters: %v", err)
+	}
+
+	snapshot.Profiles, err = collectProfiles(api)
+	if err != nil {
+		return nil, err
+	}
+
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read list of configurations: %v", err)
+	}
+	for _, configuration := range configurations {
+		payload, err := api.ReadClusterConfigurationByID(strconv.Itoa(configuration.ID))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read configuration %d: %v", configuration.ID, err)
+		}
+		record := ConfigurationRecord{ClusterConfiguration: configuration}
+		if payload != nil {
+			record.Payload = *payload
+		}
+		snapshot.Configurations = append(snapshot.Configurations, record)
+	}
+
+	snapshot.Triggers, err = api.ReadListOfTriggers()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read list of triggers: %v", err)
+	}
+
+	return &snapshot, nil
+}
@@ -0,0 +1,432 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/backup
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/restore.html
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// Remap represents object that has different ID in the target controller
+type Remap struct {
+	Kind  string
+	OldID int
+	NewID int
+}
+
+// Report contains result of snapshot restore
+type Report struct {
+	// number of created objects of each kind
+	Created map[string]int
+
+	// objects with different IDs in the target controller
+	Remapped []Remap
+
+	// objects that already exist in the target controller or that can't
+	// be restored
+	Conflicts []string
+
+	// operations that failed
+	Errors []string
+
+	// snapshot profiles that have been created together with
+	// configurations
+	profilesWithConfigurations map[int]bool
+}
+
+// conflict method adds new conflict into report
+func (report *Report) conflict(format string, args ...interface{}) {
+	report.Conflicts = append(report.Conflicts, fmt.Sprintf(format, args...))
+}
+
+// failure method adds new error into report
+func (report *Report) failure(format string, args ...interface{}) {
+	report.Errors = append(report.Errors, fmt.Sprintf(format, args...))
+}
+
+// remap method records the new ID of object if it is different
+func (report *Report) remap(kind string, oldID, newID int) {
+	if oldID != newID {
+		report.Remapped = append(report.Remapped, Remap{kind, oldID, newID})
+	}
+}
+
+// newIDs function returns IDs that are in the current list and not in the
+// previous one, sorted in ascending order
+func newIDs(previous, current []int) []int {
+	known := map[int]bool{}
+	for _, id := range previous {
+		known[id] = true
+	}
+	ids := []int{}
+	for _, id := range current {
+		if !known[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Restore function replays the snapshot into controller service. Objects
+// that already exist are reused or skipped and reported as conflicts. REST
+// API does not allow to choose IDs of new objects, so new IDs are read back
+// and reported. Fatal error is returned only when the state of target
+// controller can't be read.
+//
+// Controller creates new profile for each new configuration, so
+// configurations are restored before profiles and the profiles they refer to
+// are not created again.
+func Restore(api restapi.API, snapshot *Snapshot, username string) (*Report, error) {
+	report := Report{
+		Created:                    map[string]int{},
+		profilesWithConfigurations: map[int]bool{},
+	}
+
+	steps := []func(restapi.API, *Snapshot, string, *Report) error{
+		restoreClusters,
+		restoreConfigurations,
+		restoreProfiles,
+		restoreTriggers,
+	}
+	for _, step := range steps {
+		err := step(api, snapshot, username, &report)
+		if err != nil {
+			return &report, err
+		}
+	}
+	return &report, nil
+}
+
+// restoreClusters function registers all clusters that are not known to the
+// target controller
+func restoreClusters(api restapi.API, snapshot *Snapshot, username string, report *Report) error {
+	existing, err := api.ReadListOfClusters()
+	if err != nil {
+		return fmt.Errorf("unable to read list of clusters: %v", err)
+	}
+	byName := map[string]types.Cluster{}
+	for _, cluster := range existing {
+		byName[cluster.Name] = cluster
+	}
+
+	added := false
+	for _, cluster := range snapshot.Clusters {
+		if found, exists := byName[cluster.Name]; exists {
+			report.conflict("cluster %s already exists, it is reused", cluster.Name)
+			report.remap(ClusterKind, cluster.ID, found.ID)
+			continue
+		}
+		err := api.AddCluster(cluster.Name)
+		if err != nil {
+			report.failure("cluster %s can not be added: %v", cluster.Name, err)
+			continue
+		}
+		report.Created[ClusterKind]++
+		added = true
+	}
+	if !added {
+		return nil
+	}
+
+	// clusters are identified by their names, so new IDs are easy to find
+	current, err := api.ReadListOfClusters()
+	if err != nil {
+		return fmt.Errorf("unable to read list of clusters: %v", err)
+	}
+	for _, cluster := range snapshot.Clusters {
+		if _, exists := byName[cluster.Name]; exists {
+			continue
+		}
+		for _, created := range current {
+			if created.Name == cluster.Name {
+				report.remap(ClusterKind, cluster.ID, created.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// profileIDs function returns IDs of all profiles
+func profileIDs(profiles []types.ConfigurationProfile) []int {
+	ids := make([]int, len(profiles))
+	for i, profile := range profiles {
+		ids[i] = profile.ID
+	}
+	return ids
+}
+
+// restoreProfiles function creates all configuration profiles that don't
+// exist in the target controller. Profiles with the same description and
+// payload are reused, profiles created together with configurations are
+// skipped.
+func restoreProfiles(api restapi.API, snapshot *Snapshot, username string, report *Report) error {
+	existing, err := collectProfiles(api)
+	if err != nil {
+		return err
+	}
+	type profileKey struct{ description, configuration string }
+	known := map[profileKey]int{}
+	for _, profile := range existing {
+		known[profileKey{profile.Description, profile.Configuration}] = profile.ID
+	}
+
+	added := []types.ConfigurationProfile{}
+	for _, profile := range snapshot.Profiles {
+		if report.profilesWithConfigurations[profile.ID] {
+			continue
+		}
+		if id, exists := known[profileKey{profile.Description, profile.Configuration}]; exists {
+			report.conflict("profile %d (%s) already exists as profile %d, it is reused",
+				profile.ID, profile.Description, id)
+			report.remap(ProfileKind, profile.ID, id)
+			continue
+		}
+		err := api.AddConfigurationProfile(username, profile.Description, []byte(profile.Configuration))
+		if err != nil {
+			report.failure("profile %d (%s) can not be added: %v", profile.ID, profile.Description, err)
+			continue
+		}
+		report.Created[ProfileKind]++
+		added = append(added, profile)
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	// new profiles have the highest IDs and they are created in order
+	current, err := api.ReadListOfConfigurationProfiles()
+	if err != nil {
+		return fmt.Errorf("unable to read list of configuration profiles: %v", err)
+	}
+	ids := newIDs(profileIDs(existing), profileIDs(current))
+	if len(ids) != len(added) {
+		report.failure("IDs of new profiles can not be determined")
+		return nil
+	}
+	for i, profile := range added {
+		report.remap(ProfileKind, profile.ID, ids[i])
+	}
+	return nil
+}
+
+// configurationIDs function returns IDs of all configurations for given
+// cluster
+func configurationIDs(configurations []types.ClusterConfiguration, cluster string) []int {
+	ids := []int{}
+	for _, configuration := range configurations {
+		if configuration.Cluster == cluster {
+			ids = append(ids, configuration.ID)
+		}
+	}
+	return ids
+}
+
+// restoreConfigurations function creates configurations for all clusters
+// that don't have any configuration in the target controller. Active flags
+// of new configurations are set to the same values as in the snapshot.
+func restoreConfigurations(api restapi.API, snapshot *Snapshot, username string, report *Report) error {
+	existing, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return fmt.Errorf("unable to read list of configurations: %v", err)
+	}
+
+	// configurations refer to profiles by their IDs
+	profileDescriptions := map[string]string{}
+	for _, profile := range snapshot.Profiles {
+		profileDescriptions[strconv.Itoa(profile.ID)] = profile.Description
+	}
+
+	// configurations are restored cluster by cluster in the original order
+	clusters := []string{}
+	records := map[string][]ConfigurationRecord{}
+	for _, record := range snapshot.Configurations {
+		if _, found := records[record.Cluster]; !found {
+			clusters = append(clusters, record.Cluster)
+		}
+		records[record.Cluster] = append(records[record.Cluster], record)
+	}
+
+	for _, cluster := range clusters {
+		clusterRecords := records[cluster]
+		sort.SliceStable(clusterRecords, func(i, j int) bool {
+			return clusterRecords[i].ID < clusterRecords[j].ID
+		})
+
+		previous := configurationIDs(existing, cluster)
+		if len(previous) > 0 {
+			report.conflict("cluster %s already has %d configurations, %d configurations are skipped",
+				cluster, len(previous), len(clusterRecords))
+			continue
+		}
+
+		added := []ConfigurationRecord{}
+		for _, record := range clusterRecords {
+			err := api.AddClusterConfiguration(username, cluster, record.Reason,
+				profileDescriptions[record.Configuration], []byte(record.Payload))
+			if err != nil {
+				report.failure("configuration %d can not be added: %v", record.ID, err)
+				continue
+			}
+			report.Created[ConfigurationKind]++
+			added = append(added, record)
+		}
+		if len(added) == 0 {
+			continue
+		}
+
+		current, err := api.ReadListOfConfigurations()
+		if err != nil {
+			return fmt.Errorf("unable to read list of configurations: %v", err)
+		}
+		restoreActiveFlags(api, added, current, cluster, report)
+	}
+	return nil
+}
+
+// restoreActiveFlags function finds new configurations of cluster, records
+// their IDs and IDs of profiles created together with them and enables or
+// disables them to match the snapshot
+func restoreActiveFlags(api restapi.API, added []ConfigurationRecord, current []types.ClusterConfiguration,
+	cluster string, report *Report) {
+	ids := configurationIDs(current, cluster)
+	sort.Ints(ids)
+	if len(ids) != len(added) {
+		report.failure("IDs of new configurations for cluster %s can not be determined", cluster)
+		return
+	}
+
+	active := map[int]string{}
+	profiles := map[int]string{}
+	for _, configuration := range current {
+		active[configuration.ID] = configuration.Active
+		profiles[configuration.ID] = configuration.Configuration
+	}
+
+	for i, record := range added {
+		newID := ids[i]
+		restoreProfileOfConfiguration(record.Configuration, profiles[newID], report)
+		report.remap(ConfigurationKind, record.ID, newID)
+		if active[newID] == record.Active {
+			continue
+		}
+
+		var err error
+		if record.Active == "1" {
+			err = api.EnableClusterConfiguration(strconv.Itoa(newID))
+		} else {
+			err = api.DisableClusterConfiguration(strconv.Itoa(newID))
+		}
+		if err != nil {
+			report.failure("active flag of configuration %d can not be set: %v", newID, err)
+		}
+	}
+}
+
+// restoreProfileOfConfiguration function records profile that has been
+// created together with configuration, so it is not created again
+func restoreProfileOfConfiguration(oldProfile, newProfile string, report *Report) {
+	oldID, err1 := strconv.Atoi(oldProfile)
+	newID, err2 := strconv.Atoi(newProfile)
+	if err1 != nil || err2 != nil || report.profilesWithConfigurations[oldID] {
+		return
+	}
+	report.profilesWithConfigurations[oldID] = true
+	report.Created[ProfileKind]++
+	report.remap(ProfileKind, oldID, newID)
+}
+
+// triggerKey function returns values that identify the same trigger in
+// different controllers
+func triggerKey(trigger types.Trigger) string {
+	return trigger.Cluster + "\x00" + trigger.Type + "\x00" + trigger.Reason + "\x00" + trigger.Link +
+		"\x00" + trigger.Parameters
+}
+
+// restoreTriggers function creates all triggers that don't exist in the
+// target controller, together with their types and parameters. Triggers are
+// created as active, inactive triggers are deactivated afterwards.
+// Timestamps and acknowledgements can't be restored.
+func restoreTriggers(api restapi.API, snapshot *Snapshot, username string, report *Report) error {
+	existing, err := api.ReadListOfTriggers()
+	if err != nil {
+		return fmt.Errorf("unable to read list of triggers: %v", err)
+	}
+	known := map[string]int{}
+	previous := []int{}
+	for _, trigger := range existing {
+		known[triggerKey(trigger)] = trigger.ID
+		previous = append(previous, trigger.ID)
+	}
+
+	added := []types.Trigger{}
+	for _, trigger := range snapshot.Triggers {
+		if id, exists := known[triggerKey(trigger)]; exists {
+			report.conflict("trigger %d already exists as trigger %d, it is skipped", trigger.ID, id)
+			continue
+		}
+		var parameters []byte
+		if trigger.Parameters != "" {
+			parameters = []byte(trigger.Parameters)
+		}
+		err := api.AddTypedTrigger(username, trigger.Cluster, trigger.Type, trigger.Reason, trigger.Link, parameters)
+		if err != nil {
+			report.failure("trigger %d can not be added: %v", trigger.ID, err)
+			continue
+		}
+		report.Created[TriggerKind]++
+		added = append(added, trigger)
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	current, err := api.ReadListOfTriggers()
+	if err != nil {
+		return fmt.Errorf("unable to read list of triggers: %v", err)
+	}
+	currentIDs := []int{}
+	for _, trigger := range current {
+		currentIDs = append(currentIDs, trigger.ID)
+	}
+	ids := newIDs(previous, currentIDs)
+	if len(ids) != len(added) {
+		report.failure("IDs of new triggers can not be determined")
+		return nil
+	}
+
+	for i, trigger := range added {
+		report.remap(TriggerKind, trigger.ID, ids[i])
+		if trigger.Active == 0 {
+			err := api.DeactivateTrigger(strconv.Itoa(ids[i]))
+			if err != nil {
+				report.failure("trigger %d can not be deactivated: %v", ids[i], err)
+			}
+		}
+	}
+	return nil
+}
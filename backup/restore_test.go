@@ -0,0 +1,192 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/backup/restore_test.html
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/RedHatInsights/insights-operator-cli/backup"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// sampleSnapshot function returns snapshot of sample in-memory REST API
+func sampleSnapshot(t *testing.T) *backup.Snapshot {
+	snapshot, err := backup.Collect(sampleAPI(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snapshot
+}
+
+// TestRestoreIntoEmptyController checks that all objects are created with
+// new IDs and that active flags are restored
+func TestRestoreIntoEmptyController(t *testing.T) {
+	target := newMemoryAPI(100)
+
+	report, err := backup.Restore(target, sampleSnapshot(t), "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCreated := map[string]int{
+		backup.ClusterKind:       2,
+		backup.ProfileKind:       3,
+		backup.ConfigurationKind: 2,
+		backup.TriggerKind:       2,
+	}
+	if !reflect.DeepEqual(report.Created, expectedCreated) {
+		t.Fatal("Unexpected number of created objects", report.Created)
+	}
+
+	// configuration is created together with its own profile
+	expectedRemaps := []backup.Remap{
+		{Kind: backup.ClusterKind, OldID: 1, NewID: 100},
+		{Kind: backup.ClusterKind, OldID: 2, NewID: 101},
+		{Kind: backup.ProfileKind, OldID: 4, NewID: 102},
+		{Kind: backup.ConfigurationKind, OldID: 5, NewID: 103},
+		{Kind: backup.ProfileKind, OldID: 6, NewID: 104},
+		{Kind: backup.ConfigurationKind, OldID: 7, NewID: 105},
+		{Kind: backup.ProfileKind, OldID: 3, NewID: 106},
+		{Kind: backup.TriggerKind, OldID: 8, NewID: 107},
+		{Kind: backup.TriggerKind, OldID: 9, NewID: 108},
+	}
+	if !reflect.DeepEqual(report.Remapped, expectedRemaps) {
+		t.Fatal("Unexpected remapped IDs", report.Remapped)
+	}
+	if len(report.Conflicts) != 0 || len(report.Errors) != 0 {
+		t.Fatal("No conflicts nor errors are expected", report.Conflicts, report.Errors)
+	}
+
+	if target.configurations[0].Active != "0" || target.configurations[1].Active != "1" {
+		t.Fatal("Active flags of configurations are not restored", target.configurations)
+	}
+	if target.configurations[1].Reason != "reason" || target.payloads[105] != `{"watch":["a"]}` {
+		t.Fatal("Configuration is not restored properly", target.configurations[1])
+	}
+	if target.triggers[0].Active != 1 || target.triggers[1].Active != 0 {
+		t.Fatal("Active flags of triggers are not restored", target.triggers)
+	}
+	if target.triggers[0].Link != "https://example.com" {
+		t.Fatal("Trigger is not restored properly", target.triggers[0])
+	}
+}
+
+// TestRestoreDoesNotDuplicateProfiles checks that profiles used by
+// configurations are not created again, because the controller creates them
+// together with configurations
+func TestRestoreDoesNotDuplicateProfiles(t *testing.T) {
+	target := newMemoryAPI(100)
+
+	_, err := backup.Restore(target, sampleSnapshot(t), "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(target.profiles) != 3 {
+		t.Fatal("Profiles are duplicated", target.profiles)
+	}
+	// configurations refer to profiles with their payloads
+	for _, configuration := range target.configurations {
+		profile, err := target.ReadConfigurationProfile(configuration.Configuration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if profile.Configuration != target.payloads[configuration.ID] {
+			t.Fatal("Configuration refers to unexpected profile", configuration, profile)
+		}
+	}
+}
+
+// TestRestoreTwice checks that objects that already exist are reported as
+// conflicts and they are not created again
+func TestRestoreTwice(t *testing.T) {
+	target := newMemoryAPI(100)
+	snapshot := sampleSnapshot(t)
+
+	_, err := backup.Restore(target, snapshot, "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := backup.Restore(target, snapshot, "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Created) != 0 {
+		t.Fatal("No object should be created", report.Created)
+	}
+	// two clusters, three profiles, one cluster with configurations and two
+	// triggers
+	if len(report.Conflicts) != 8 {
+		t.Fatal("Unexpected conflicts", report.Conflicts)
+	}
+	if len(report.Errors) != 0 {
+		t.Fatal("No errors are expected", report.Errors)
+	}
+	// existing clusters are reused
+	if report.Remapped[0] != (backup.Remap{Kind: backup.ClusterKind, OldID: 1, NewID: 100}) {
+		t.Fatal("Existing cluster is not reused", report.Remapped)
+	}
+	if len(target.clusters) != 2 || len(target.configurations) != 2 || len(target.triggers) != 2 {
+		t.Fatal("Objects are created again")
+	}
+}
+
+// TestRestoreTypedTrigger checks that type and parameters of trigger are
+// restored
+func TestRestoreTypedTrigger(t *testing.T) {
+	snapshot := backup.Snapshot{
+		Triggers: []types.Trigger{
+			{ID: 1, Type: "insights-gather", Cluster: "cluster-a", Parameters: `{"upload":true}`, Active: 1},
+		},
+	}
+	target := newMemoryAPI(1)
+
+	report, err := backup.Restore(target, &snapshot, "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Created[backup.TriggerKind] != 1 {
+		t.Fatal("Trigger should be created", report)
+	}
+	if target.triggers[0].Type != "insights-gather" || target.triggers[0].Parameters != `{"upload":true}` {
+		t.Fatal("Type and parameters of trigger are not restored", target.triggers[0])
+	}
+}
+
+// TestRestoreErrors checks that failed operations are reported
+func TestRestoreErrors(t *testing.T) {
+	target := newMemoryAPI(100)
+	target.err = errors.New("controller error")
+
+	report, err := backup.Restore(target, sampleSnapshot(t), "restorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// two clusters, three profiles, two configurations and two triggers
+	if len(report.Errors) != 9 {
+		t.Fatal("Unexpected errors", report.Errors)
+	}
+	if len(report.Created) != 0 {
+		t.Fatal("No object should be created", report.Created)
+	}
+}
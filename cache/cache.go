@@ -0,0 +1,59 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache contains implementation of local cache of the controller
+// state. Results of all successful read operations are stored into local file
+// so the CLI client is able to display them even when the controller service
+// is not reachable (so called offline mode).
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * cache.go
+//
+// * caching_api.go
+//
+// * offline_api.go
+//
+// * store.go
+package cache
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/cache
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/cache.html
+
+import (
+	"errors"
+)
+
+// keys used to store results of REST API calls into the cache
+const (
+	clustersKey                = "clusters"
+	configurationProfilesKey   = "profiles"
+	configurationProfileKey    = "profile/"
+	clusterConfigurationsKey   = "configurations"
+	clusterConfigurationKey    = "configuration/"
+	triggersKey                = "triggers"
+	triggerKey                 = "trigger/"
+	notCachedErrorMessage      = "data are not available in local cache: "
+	offlineModeErrorMessage    = "operation is not available in offline mode"
+	cannotReadCacheErrorPrefix = "unable to read local cache: "
+)
+
+// ErrOffline is returned by all mutating operations in offline mode
+var ErrOffline = errors.New(offlineModeErrorMessage)
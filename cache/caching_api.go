@@ -0,0 +1,196 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/cache
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/caching_api.html
+
+import (
+	"log"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// CachingAPI is an implementation of API interface that calls another
+// (usually REST API) implementation and stores results of all successful read
+// operations into the local store. All mutating operations are just passed
+// to the wrapped implementation.
+type CachingAPI struct {
+	api   restapi.API
+	store *Store
+}
+
+// NewCachingAPI function is a constructor to construct new instance of
+// caching API
+func NewCachingAPI(api restapi.API, store *Store) CachingAPI {
+	return CachingAPI{
+		api:   api,
+		store: store,
+	}
+}
+
+// remember method stores result of successful read operation into the store.
+// Inability to store the result is not fatal, because the cache is used in
+// offline mode only.
+func (api CachingAPI) remember(key string, value interface{}) {
+	err := api.store.Put(key, value)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// ReadListOfClusters method reads list of clusters and stores it into cache
+func (api CachingAPI) ReadListOfClusters() ([]types.Cluster, error) {
+	clusters, err := api.api.ReadListOfClusters()
+	if err == nil {
+		api.remember(clustersKey, clusters)
+	}
+	return clusters, err
+}
+
+// AddCluster method adds/registers new cluster
+func (api CachingAPI) AddCluster(name string) error {
+	return api.api.AddCluster(name)
+}
+
+// DeleteCluster method deletes/deregisters existing cluster
+func (api CachingAPI) DeleteCluster(clusterID string) error {
+	return api.api.DeleteCluster(clusterID)
+}
+
+// ReadListOfConfigurationProfiles method reads list of configuration profiles
+// and stores it into cache
+func (api CachingAPI) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	profiles, err := api.api.ReadListOfConfigurationProfiles()
+	if err == nil {
+		api.remember(configurationProfilesKey, profiles)
+	}
+	return profiles, err
+}
+
+// ReadConfigurationProfile method reads selected configuration profile and
+// stores it into cache
+func (api CachingAPI) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	profile, err := api.api.ReadConfigurationProfile(profileID)
+	if err == nil {
+		api.remember(configurationProfileKey+profileID, profile)
+	}
+	return profile, err
+}
+
+// AddConfigurationProfile method adds new configuration profile
+func (api CachingAPI) AddConfigurationProfile(username, description string, configuration []byte) error {
+	return api.api.AddConfigurationProfile(username, description, configuration)
+}
+
+// UpdateConfigurationProfile method updates existing configuration profile
+func (api CachingAPI) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	return api.api.UpdateConfigurationProfile(profileID, username, description, configuration)
+}
+
+// DeleteConfigurationProfile method deletes existing configuration profile
+func (api CachingAPI) DeleteConfigurationProfile(profileID string) error {
+	return api.api.DeleteConfigurationProfile(profileID)
+}
+
+// ReadListOfConfigurations method reads list of cluster configurations and
+// stores it into cache
+func (api CachingAPI) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	configurations, err := api.api.ReadListOfConfigurations()
+	if err == nil {
+		api.remember(clusterConfigurationsKey, configurations)
+	}
+	return configurations, err
+}
+
+// ReadClusterConfigurationByID method reads cluster configuration identified
+// by its ID and stores it into cache
+func (api CachingAPI) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	configuration, err := api.api.ReadClusterConfigurationByID(configurationID)
+	if err == nil {
+		api.remember(clusterConfigurationKey+configurationID, configuration)
+	}
+	return configuration, err
+}
+
+// AddClusterConfiguration method adds new cluster configuration
+func (api CachingAPI) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	return api.api.AddClusterConfiguration(username, cluster, reason, description, configuration)
+}
+
+// EnableClusterConfiguration method enables existing cluster configuration
+func (api CachingAPI) EnableClusterConfiguration(configurationID string) error {
+	return api.api.EnableClusterConfiguration(configurationID)
+}
+
+// DisableClusterConfiguration method disables existing cluster configuration
+func (api CachingAPI) DisableClusterConfiguration(configurationID string) error {
+	return api.api.DisableClusterConfiguration(configurationID)
+}
+
+// DeleteClusterConfiguration method deletes existing cluster configuration
+func (api CachingAPI) DeleteClusterConfiguration(configurationID string) error {
+	return api.api.DeleteClusterConfiguration(configurationID)
+}
+
+// ReadListOfTriggers method reads list of triggers and stores it into cache
+func (api CachingAPI) ReadListOfTriggers() ([]types.Trigger, error) {
+	triggers, err := api.api.ReadListOfTriggers()
+	if err == nil {
+		api.remember(triggersKey, triggers)
+	}
+	return triggers, err
+}
+
+// ReadTriggerByID method reads trigger identified by its ID and stores it
+// into cache
+func (api CachingAPI) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	trigger, err := api.api.ReadTriggerByID(triggerID)
+	if err == nil {
+		api.remember(triggerKey+triggerID, trigger)
+	}
+	return trigger, err
+}
+
+// AddTrigger method adds/registers new trigger
+func (api CachingAPI) AddTrigger(username, clusterName, reason, link string) error {
+	return api.api.AddTrigger(username, clusterName, reason, link)
+}
+
+// AddTypedTrigger method adds/registers new trigger of given type
+func (api CachingAPI) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	return api.api.AddTypedTrigger(username, clusterName, triggerType, reason, link, parameters)
+}
+
+// DeleteTrigger method deletes the selected trigger
+func (api CachingAPI) DeleteTrigger(triggerID string) error {
+	return api.api.DeleteTrigger(triggerID)
+}
+
+// ActivateTrigger method activates the selected trigger
+func (api CachingAPI) ActivateTrigger(triggerID string) error {
+	return api.api.ActivateTrigger(triggerID)
+}
+
+// DeactivateTrigger method deactivates the selected trigger
+func (api CachingAPI) DeactivateTrigger(triggerID string) error {
+	return api.api.DeactivateTrigger(triggerID)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/caching_api_test.html
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RedHatInsights/insights-operator-cli/cache"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// TestCachingAPIStoresReadResults checks that results of read operations are
+// stored into cache and can be served by offline API
+func TestCachingAPIStoresReadResults(t *testing.T) {
+	store, _ := newStore(t)
+	api := cache.NewCachingAPI(RestAPIMock{}, store)
+
+	// perform all read operations
+	_, err := api.ReadListOfClusters()
+	expectNoErrors(t, err)
+	_, err = api.ReadListOfConfigurationProfiles()
+	expectNoErrors(t, err)
+	_, err = api.ReadConfigurationProfile("1")
+	expectNoErrors(t, err)
+	_, err = api.ReadListOfConfigurations()
+	expectNoErrors(t, err)
+	_, err = api.ReadClusterConfigurationByID("1")
+	expectNoErrors(t, err)
+	_, err = api.ReadListOfTriggers()
+	expectNoErrors(t, err)
+	_, err = api.ReadTriggerByID("1")
+	expectNoErrors(t, err)
+
+	// all results should be available in offline mode now
+	offline := cache.NewOfflineAPI(store, nil)
+
+	clusters, err := offline.ReadListOfClusters()
+	expectNoErrors(t, err)
+	if len(clusters) != 2 {
+		t.Fatal("Unexpected list of clusters:", clusters)
+	}
+
+	profile, err := offline.ReadConfigurationProfile("1")
+	expectNoErrors(t, err)
+	if profile.Description != "default configuration profile" {
+		t.Fatal("Unexpected profile:", profile)
+	}
+
+	configuration, err := offline.ReadClusterConfigurationByID("1")
+	expectNoErrors(t, err)
+	if *configuration != `{"no_op":"X"}` {
+		t.Fatal("Unexpected configuration:", *configuration)
+	}
+
+	trigger, err := offline.ReadTriggerByID("1")
+	expectNoErrors(t, err)
+	if trigger.Type != "must-gather" {
+		t.Fatal("Unexpected trigger:", trigger)
+	}
+}
+
+// TestCachingAPIDoesNotStoreErrors checks that failed read operations don't
+// change content of cache
+func TestCachingAPIDoesNotStoreErrors(t *testing.T) {
+	store, _ := newStore(t)
+	api := cache.NewCachingAPI(RestAPIMock{err: errors.New("REST API error")}, store)
+
+	_, err := api.ReadListOfTriggers()
+	expectError(t, err)
+
+	var triggers []types.Trigger
+	_, err = store.Get("triggers", &triggers)
+	expectError(t, err)
+}
+
+// TestCachingAPIPassesMutatingOperations checks that mutating operations are
+// passed to wrapped API
+func TestCachingAPIPassesMutatingOperations(t *testing.T) {
+	store, _ := newStore(t)
+	api := cache.NewCachingAPI(RestAPIMock{err: errors.New("REST API error")}, store)
+
+	expectError(t, api.AddCluster("cluster"))
+	expectError(t, api.DeleteCluster("1"))
+	expectError(t, api.AddConfigurationProfile("tester", "description", nil))
+	expectError(t, api.UpdateConfigurationProfile("1", "tester", "description", nil))
+	expectError(t, api.DeleteConfigurationProfile("1"))
+	expectError(t, api.AddClusterConfiguration("tester", "cluster", "reason", "description", nil))
+	expectError(t, api.EnableClusterConfiguration("1"))
+	expectError(t, api.DisableClusterConfiguration("1"))
+	expectError(t, api.DeleteClusterConfiguration("1"))
+	expectError(t, api.AddTrigger("tester", "cluster", "reason", "link"))
+	expectError(t, api.AddTypedTrigger("tester", "cluster", "must-gather", "reason", "link", nil))
+	expectError(t, api.DeleteTrigger("1"))
+	expectError(t, api.ActivateTrigger("1"))
+	expectError(t, api.DeactivateTrigger("1"))
+}
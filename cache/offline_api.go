@@ -0,0 +1,205 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/cache
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/offline_api.html
+
+import (
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// OfflineAPI is an implementation of API interface that does not communicate
+// with the controller service at all. All read operations are served from the
+// local store and all mutating operations are refused.
+type OfflineAPI struct {
+	store *Store
+
+	// onServe callback is called each time data are served from the
+	// store so the user can be informed how old the data are
+	onServe func(key string, storedAt time.Time)
+}
+
+// NewOfflineAPI function is a constructor to construct new instance of
+// offline API. The onServe callback can be nil.
+func NewOfflineAPI(store *Store, onServe func(key string, storedAt time.Time)) OfflineAPI {
+	return OfflineAPI{
+		store:   store,
+		onServe: onServe,
+	}
+}
+
+// serve method reads value stored under given key and notifies the callback
+func (api OfflineAPI) serve(key string, value interface{}) error {
+	storedAt, err := api.store.Get(key, value)
+	if err != nil {
+		return err
+	}
+	if api.onServe != nil {
+		api.onServe(key, storedAt)
+	}
+	return nil
+}
+
+// ReadListOfClusters method reads list of clusters from cache
+func (api OfflineAPI) ReadListOfClusters() ([]types.Cluster, error) {
+	var clusters []types.Cluster
+	err := api.serve(clustersKey, &clusters)
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+// AddCluster method is not available in offline mode
+func (api OfflineAPI) AddCluster(name string) error {
+	return ErrOffline
+}
+
+// DeleteCluster method is not available in offline mode
+func (api OfflineAPI) DeleteCluster(clusterID string) error {
+	return ErrOffline
+}
+
+// ReadListOfConfigurationProfiles method reads list of configuration profiles
+// from cache
+func (api OfflineAPI) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	var profiles []types.ConfigurationProfile
+	err := api.serve(configurationProfilesKey, &profiles)
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// ReadConfigurationProfile method reads selected configuration profile from
+// cache
+func (api OfflineAPI) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	var profile types.ConfigurationProfile
+	err := api.serve(configurationProfileKey+profileID, &profile)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// AddConfigurationProfile method is not available in offline mode
+func (api OfflineAPI) AddConfigurationProfile(username, description string, configuration []byte) error {
+	return ErrOffline
+}
+
+// UpdateConfigurationProfile method is not available in offline mode
+func (api OfflineAPI) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	return ErrOffline
+}
+
+// DeleteConfigurationProfile method is not available in offline mode
+func (api OfflineAPI) DeleteConfigurationProfile(profileID string) error {
+	return ErrOffline
+}
+
+// ReadListOfConfigurations method reads list of cluster configurations from
+// cache
+func (api OfflineAPI) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	var configurations []types.ClusterConfiguration
+	err := api.serve(clusterConfigurationsKey, &configurations)
+	if err != nil {
+		return nil, err
+	}
+	return configurations, nil
+}
+
+// ReadClusterConfigurationByID method reads cluster configuration identified
+// by its ID from cache
+func (api OfflineAPI) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	var configuration string
+	err := api.serve(clusterConfigurationKey+configurationID, &configuration)
+	if err != nil {
+		return nil, err
+	}
+	return &configuration, nil
+}
+
+// AddClusterConfiguration method is not available in offline mode
+func (api OfflineAPI) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	return ErrOffline
+}
+
+// EnableClusterConfiguration method is not available in offline mode
+func (api OfflineAPI) EnableClusterConfiguration(configurationID string) error {
+	return ErrOffline
+}
+
+// DisableClusterConfiguration method is not available in offline mode
+func (api OfflineAPI) DisableClusterConfiguration(configurationID string) error {
+	return ErrOffline
+}
+
+// DeleteClusterConfiguration method is not available in offline mode
+func (api OfflineAPI) DeleteClusterConfiguration(configurationID string) error {
+	return ErrOffline
+}
+
+// ReadListOfTriggers method reads list of triggers from cache
+func (api OfflineAPI) ReadListOfTriggers() ([]types.Trigger, error) {
+	var triggers []types.Trigger
+	err := api.serve(triggersKey, &triggers)
+	if err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+// ReadTriggerByID method reads trigger identified by its ID from cache
+func (api OfflineAPI) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	var trigger types.Trigger
+	err := api.serve(triggerKey+triggerID, &trigger)
+	if err != nil {
+		return nil, err
+	}
+	return &trigger, nil
+}
+
+// AddTrigger method is not available in offline mode
+func (api OfflineAPI) AddTrigger(username, clusterName, reason, link string) error {
+	return ErrOffline
+}
+
+// AddTypedTrigger method is not available in offline mode
+func (api OfflineAPI) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	return ErrOffline
+}
+
+// DeleteTrigger method is not available in offline mode
+func (api OfflineAPI) DeleteTrigger(triggerID string) error {
+	return ErrOffline
+}
+
+// ActivateTrigger method is not available in offline mode
+func (api OfflineAPI) ActivateTrigger(triggerID string) error {
+	return ErrOffline
+}
+
+// DeactivateTrigger method is not available in offline mode
+func (api OfflineAPI) DeactivateTrigger(triggerID string) error {
+	return ErrOffline
+}
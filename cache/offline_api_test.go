@@ -0,0 +1,111 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/offline_api_test.html
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/cache"
+)
+
+// expectNoErrors checks if the error is not reported
+func expectNoErrors(t *testing.T, err error) {
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expectError checks if the error is reported
+func expectError(t *testing.T, err error) {
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+}
+
+// expectOfflineError checks if the operation has been refused
+func expectOfflineError(t *testing.T, err error) {
+	if err != cache.ErrOffline {
+		t.Fatal("Operation should be refused in offline mode, got:", err)
+	}
+}
+
+// TestOfflineAPIReportsStaleData checks that callback is called with
+// timestamp when data has been stored
+func TestOfflineAPIReportsStaleData(t *testing.T) {
+	store, _ := newStore(t)
+	err := store.Put("clusters", []string{})
+	expectNoErrors(t, err)
+
+	var servedKey string
+	var servedAt time.Time
+	api := cache.NewOfflineAPI(store, func(key string, storedAt time.Time) {
+		servedKey = key
+		servedAt = storedAt
+	})
+
+	_, err = api.ReadListOfClusters()
+	expectNoErrors(t, err)
+
+	if servedKey != "clusters" {
+		t.Fatal("Unexpected key reported:", servedKey)
+	}
+	if servedAt.IsZero() || servedAt.After(time.Now()) {
+		t.Fatal("Unexpected timestamp reported:", servedAt)
+	}
+}
+
+// TestOfflineAPIMissingData checks that data not available in cache are
+// reported as an error
+func TestOfflineAPIMissingData(t *testing.T) {
+	store, _ := newStore(t)
+	api := cache.NewOfflineAPI(store, nil)
+
+	_, err := api.ReadListOfClusters()
+	expectError(t, err)
+	_, err = api.ReadListOfConfigurationProfiles()
+	expectError(t, err)
+	_, err = api.ReadListOfConfigurations()
+	expectError(t, err)
+	_, err = api.ReadListOfTriggers()
+	expectError(t, err)
+}
+
+// TestOfflineAPIRefusesMutatingOperations checks that all mutating operations
+// are refused in offline mode
+func TestOfflineAPIRefusesMutatingOperations(t *testing.T) {
+	store, _ := newStore(t)
+	api := cache.NewOfflineAPI(store, nil)
+
+	expectOfflineError(t, api.AddCluster("cluster"))
+	expectOfflineError(t, api.DeleteCluster("1"))
+	expectOfflineError(t, api.AddConfigurationProfile("tester", "description", nil))
+	expectOfflineError(t, api.UpdateConfigurationProfile("1", "tester", "description", nil))
+	expectOfflineError(t, api.DeleteConfigurationProfile("1"))
+	expectOfflineError(t, api.AddClusterConfiguration("tester", "cluster", "reason", "description", nil))
+	expectOfflineError(t, api.EnableClusterConfiguration("1"))
+	expectOfflineError(t, api.DisableClusterConfiguration("1"))
+	expectOfflineError(t, api.DeleteClusterConfiguration("1"))
+	expectOfflineError(t, api.AddTrigger("tester", "cluster", "reason", "link"))
+	expectOfflineError(t, api.AddTypedTrigger("tester", "cluster", "must-gather", "reason", "link", nil))
+	expectOfflineError(t, api.DeleteTrigger("1"))
+	expectOfflineError(t, api.ActivateTrigger("1"))
+	expectOfflineError(t, api.DeactivateTrigger("1"))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+// Mock object used by unit tests for REST API. All methods return the same
+// data and the error that is configured in the mock structure.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/rest_api_mock_test.html
+
+import (
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// RestAPIMock structure is an implementation of mocked REST API
+type RestAPIMock struct {
+	// error to be returned from all methods
+	err error
+}
+
+// ReadListOfClusters returns mocked list of clusters
+func (api RestAPIMock) ReadListOfClusters() ([]types.Cluster, error) {
+	clusters := []types.Cluster{
+		{ID: 1, Name: "00000000-0000-0000-0000-000000000000"},
+		{ID: 2, Name: "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+	}
+	return clusters, api.err
+}
+
+// AddCluster returns configured error
+func (api RestAPIMock) AddCluster(name string) error {
+	return api.err
+}
+
+// DeleteCluster returns configured error
+func (api RestAPIMock) DeleteCluster(clusterID string) error {
+	return api.err
+}
+
+// ReadListOfConfigurationProfiles returns mocked list of profiles
+func (api RestAPIMock) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	profiles := []types.ConfigurationProfile{
+		{ID: 1, Description: "default configuration profile"},
+	}
+	return profiles, api.err
+}
+
+// ReadConfigurationProfile returns mocked configuration profile
+func (api RestAPIMock) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	profile := types.ConfigurationProfile{
+		ID:            1,
+		Configuration: `{"no_op":"X"}`,
+		Description:   "default configuration profile",
+	}
+	return &profile, api.err
+}
+
+// AddConfigurationProfile returns configured error
+func (api RestAPIMock) AddConfigurationProfile(username, description string, configuration []byte) error {
+	return api.err
+}
+
+// UpdateConfigurationProfile returns configured error
+func (api RestAPIMock) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	return api.err
+}
+
+// DeleteConfigurationProfile returns configured error
+func (api RestAPIMock) DeleteConfigurationProfile(profileID string) error {
+	return api.err
+}
+
+// ReadListOfConfigurations returns mocked list of configurations
+func (api RestAPIMock) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	configurations := []types.ClusterConfiguration{
+		{ID: 1, Cluster: "00000000-0000-0000-0000-000000000000", Configuration: "1", Active: "1"},
+	}
+	return configurations, api.err
+}
+
+// ReadClusterConfigurationByID returns mocked cluster configuration
+func (api RestAPIMock) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	configuration := `{"no_op":"X"}`
+	return &configuration, api.err
+}
+
+// AddClusterConfiguration returns configured error
+func (api RestAPIMock) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	return api.err
+}
+
+// EnableClusterConfiguration returns configured error
+func (api RestAPIMock) EnableClusterConfiguration(configurationID string) error {
+	return api.err
+}
+
+// DisableClusterConfiguration returns configured error
+func (api RestAPIMock) DisableClusterConfiguration(configurationID string) error {
+	return api.err
+}
+
+// DeleteClusterConfiguration returns configured error
+func (api RestAPIMock) DeleteClusterConfiguration(configurationID string) error {
+	return api.err
+}
+
+// ReadListOfTriggers returns mocked list of triggers
+func (api RestAPIMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	triggers := []types.Trigger{
+		{ID: 1, Type: "must-gather", Cluster: "00000000-0000-0000-0000-000000000000", Active: 1},
+	}
+	return triggers, api.err
+}
+
+// ReadTriggerByID returns mocked trigger
+func (api RestAPIMock) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	trigger := types.Trigger{ID: 1, Type: "must-gather", Active: 1}
+	return &trigger, api.err
+}
+
+// AddTrigger returns configured error
+func (api RestAPIMock) AddTrigger(username, clusterName, reason, link string) error {
+	return api.err
+}
+
+// AddTypedTrigger returns configured error
+func (api RestAPIMock) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	return api.err
+}
+
+// DeleteTrigger returns configured error
+func (api RestAPIMock) DeleteTrigger(triggerID string) error {
+	return api.err
+}
+
+// ActivateTrigger returns configured error
+func (api RestAPIMock) ActivateTrigger(triggerID string) error {
+	return api.err
+}
+
+// DeactivateTrigger returns configured error
+func (api RestAPIMock) DeactivateTrigger(triggerID string) error {
+	return api.err
+}
@@ -0,0 +1,153 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/cache
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/store.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Entry represents one cached result of REST API call together with the
+// timestamp when the result has been stored.
+type Entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// Store represents local file-based storage for cached results of REST API
+// calls. The whole content of the store is kept in memory and it is written
+// into the file after each change.
+type Store struct {
+	filename string
+	mutex    sync.Mutex
+	entries  map[string]Entry
+}
+
+// DefaultStoreFile function returns path to the file used to store cached
+// data when no other file is specified in configuration.
+func DefaultStoreFile() (string, error) {
+	directory, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(directory, "insights-operator-cli", "cache.json"), nil
+}
+
+// NewStore function is a constructor to construct new instance of store. The
+// content of existing file is read, non-existing file is not considered to be
+// an error (it will be created when the first entry is stored).
+func NewStore(filename string) (*Store, error) {
+	store := Store{
+		filename: filename,
+		entries:  map[string]Entry{},
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	content, err := os.ReadFile(filename) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) {
+		return &store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &store.entries)
+	if err != nil {
+		return nil, fmt.Errorf(cannotReadCacheErrorPrefix+"%v", err)
+	}
+	return &store, nil
+}
+
+// Put method stores the given value under specified key and writes the whole
+// store into its file.
+func (store *Store) Put(key string, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.entries[key] = Entry{
+		StoredAt: time.Now(),
+		Payload:  payload,
+	}
+	return store.write()
+}
+
+// Get method reads value stored under specified key and returns the
+// timestamp when the value has been stored.
+func (store *Store) Get(key string, value interface{}) (time.Time, error) {
+	store.mutex.Lock()
+	entry, found := store.entries[key]
+	store.mutex.Unlock()
+
+	if !found {
+		return time.Time{}, errors.New(notCachedErrorMessage + key)
+	}
+
+	err := json.Unmarshal(entry.Payload, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(cannotReadCacheErrorPrefix+"%v", err)
+	}
+	return entry.StoredAt, nil
+}
+
+// write method writes all entries into the store file. The file is written
+// under unique temporary name first so it is not damaged when write fails and
+// so more processes sharing the same cache don't overwrite each other's
+// temporary file.
+func (store *Store) write() error {
+	content, err := json.Marshal(store.entries)
+	if err != nil {
+		return err
+	}
+
+	directory := filepath.Dir(store.filename)
+	err = os.MkdirAll(directory, 0o700)
+	if err != nil {
+		return err
+	}
+
+	temporary, err := os.CreateTemp(directory, filepath.Base(store.filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = temporary.Write(content)
+	if closeErr := temporary.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temporary.Name(), store.filename)
+	}
+	if err != nil {
+		_ = os.Remove(temporary.Name())
+	}
+	return err
+}
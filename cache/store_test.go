@@ -0,0 +1,132 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/cache/store_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RedHatInsights/insights-operator-cli/cache"
+)
+
+// newStore helper function constructs new store in temporary directory
+func newStore(t *testing.T) (*cache.Store, string) {
+	filename := filepath.Join(t.TempDir(), "cache.json")
+	store, err := cache.NewStore(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, filename
+}
+
+// TestNewStoreNonExistingFile checks that store can be constructed for file
+// that does not exist yet
+func TestNewStoreNonExistingFile(t *testing.T) {
+	store, _ := newStore(t)
+	if store == nil {
+		t.Fatal("Store should be constructed")
+	}
+}
+
+// TestNewStoreImproperFile checks that store can not be constructed from
+// file that does not contain cached data
+func TestNewStoreImproperFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "cache.json")
+	err := os.WriteFile(filename, []byte("this is not proper JSON"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cache.NewStore(filename)
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+}
+
+// TestStorePutGet checks that stored value can be read back, even by another
+// instance of store
+func TestStorePutGet(t *testing.T) {
+	store, filename := newStore(t)
+
+	err := store.Put("key", []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// new instance reads the data from file
+	store, err = cache.NewStore(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var value []string
+	storedAt, err := store.Get("key", &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storedAt.IsZero() {
+		t.Fatal("Timestamp should be stored together with value")
+	}
+	if len(value) != 2 || value[0] != "a" || value[1] != "b" {
+		t.Fatal("Unexpected value read from store:", value)
+	}
+}
+
+// TestStoreGetMissingKey checks that missing key is reported as an error
+func TestStoreGetMissingKey(t *testing.T) {
+	store, _ := newStore(t)
+
+	var value []string
+	_, err := store.Get("key", &value)
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+}
+
+// TestStoreConcurrentWriters checks that more stores sharing the same file
+// can write it at the same time and no temporary file is left behind
+func TestStoreConcurrentWriters(t *testing.T) {
+	_, filename := newStore(t)
+
+	errs := make(chan error)
+	for i := 0; i < 10; i++ {
+		go func() {
+			store, err := cache.NewStore(filename)
+			if err == nil {
+				err = store.Put("key", []string{"a"})
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatal("Temporary files are left in cache directory:", entries)
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/activation.html
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// activeConfigurations function returns number of active configurations
+func activeConfigurations(configurations []types.ClusterConfiguration) int {
+	active := 0
+	for _, configuration := range configurations {
+		if configuration.Active == "1" {
+			active++
+		}
+	}
+	return active
+}
+
+// activateExclusively function makes the selected configuration the only
+// active configuration of a cluster. All configurations of the cluster need
+// to be passed in siblings. The target is enabled first, so the cluster
+// never stays without active configuration. REST API does not support
+// transactions, so when any operation fails, all changes already made are
+// reverted in the opposite order. Number of disabled configurations is
+// returned.
+func activateExclusively(api restapi.API, siblings []types.ClusterConfiguration, target int) (int, error) {
+	// operations that revert changes already made
+	undo := []func() error{}
+
+	targetID := strconv.Itoa(siblings[target].ID)
+	if siblings[target].Active != "1" {
+		err := api.EnableClusterConfiguration(targetID)
+		if err != nil {
+			return 0, fmt.Errorf("configuration %s can not be enabled: %v", targetID, err)
+		}
+		undo = append(undo, func() error {
+			return api.DisableClusterConfiguration(targetID)
+		})
+	}
+
+	disabled := 0
+	for i, configuration := range siblings {
+		if i == target || configuration.Active != "1" {
+			continue
+		}
+		id := strconv.Itoa(configuration.ID)
+		err := api.DisableClusterConfiguration(id)
+		if err != nil {
+			err = fmt.Errorf("configuration %s can not be disabled: %v", id, err)
+			return 0, revertActivation(undo, err)
+		}
+		undo = append(undo, func() error {
+			return api.EnableClusterConfiguration(id)
+		})
+		disabled++
+	}
+	return disabled, nil
+}
+
+// revertActivation function performs all undo operations in the opposite
+// order and returns the original error extended by info about the revert
+func revertActivation(undo []func() error, cause error) error {
+	for i := len(undo) - 1; i >= 0; i-- {
+		err := undo[i]()
+		if err != nil {
+			return fmt.Errorf("%v; original state can not be restored: %v", cause, err)
+		}
+	}
+	return fmt.Errorf("%v; original state has been restored", cause)
+}
+
+// ActivateClusterConfiguration function makes the configuration selected by
+// its ID the only active configuration of its cluster. All other active
+// configurations of the same cluster are disabled.
+func ActivateClusterConfiguration(api restapi.API, args []string) error {
+	flags := newFlagSet("activate configuration")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		err = fmt.Errorf("exactly one configuration needs to be specified")
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	configurationID := positional[0]
+
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+	configuration, err := findConfiguration(configurations, configurationID)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotActivateConfigurationErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	siblings := clusterHistory(configurations, configuration.Cluster)
+	target := 0
+	for i := range siblings {
+		if siblings[i].ID == configuration.ID {
+			target = i
+		}
+	}
+
+	disabled, err := activateExclusively(api, siblings, target)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotActivateConfigurationErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, configuration is the only active one
+	fmt.Println(colorizer.Blue(configurationChangeMsg+configurationID+hasBeenMessage), colorizer.Green("activated"))
+	if disabled > 0 {
+		fmt.Println(colorizer.Blue(fmt.Sprintf("%d other configurations of cluster %s have been", disabled, configuration.Cluster)),
+			colorizer.Red("disabled"))
+	}
+	return nil
+}
+
+// activationProblem represents cluster that does not have exactly one
+// active configuration
+type activationProblem struct {
+	cluster  types.Cluster
+	active   int
+	siblings []types.ClusterConfiguration
+
+	// index of configuration that should be the only active one
+	proposed int
+}
+
+// findActivationProblems function finds all clusters with zero or more
+// active configurations. The newest active configuration (or the newest
+// configuration if none is active) is proposed to be the only active one.
+// Clusters without any configuration are not problems that could be fixed,
+// they are returned separately.
+func findActivationProblems(clusters []types.Cluster, configurations []types.ClusterConfiguration) ([]activationProblem, []types.Cluster) {
+	problems := []activationProblem{}
+	unconfigured := []types.Cluster{}
+	for _, cluster := range clusters {
+		siblings := clusterHistory(configurations, cluster.Name)
+		if len(siblings) == 0 {
+			unconfigured = append(unconfigured, cluster)
+			continue
+		}
+		active := activeConfigurations(siblings)
+		if active == 1 {
+			continue
+		}
+
+		proposed := currentConfigurationIndex(siblings)
+		if proposed < 0 {
+			proposed = len(siblings) - 1
+		}
+		problems = append(problems, activationProblem{
+			cluster:  cluster,
+			active:   active,
+			siblings: siblings,
+			proposed: proposed,
+		})
+	}
+	return problems, unconfigured
+}
+
+// printUnconfiguredClusters function displays all clusters without any
+// configuration, just for user's information
+func printUnconfiguredClusters(clusters []types.Cluster) {
+	fmt.Println(colorizer.Magenta("Clusters without any configuration"))
+	fmt.Printf("%4s %s\n", "ID", "Cluster")
+	for _, cluster := range clusters {
+		fmt.Printf("%4d %s\n", cluster.ID, cluster.Name)
+	}
+}
+
+// printActivationProblems function displays all clusters that do not have
+// exactly one active configuration
+func printActivationProblems(problems []activationProblem) {
+	fmt.Println(colorizer.Magenta("Clusters without exactly one active configuration"))
+	fmt.Printf("%4s %-36s %6s %14s %s\n", "ID", "Cluster", "Active", "Configurations", "Proposed fix")
+	for _, problem := range problems {
+		fmt.Printf("%4d %-36s %6d %14d activate %d\n", problem.cluster.ID, problem.cluster.Name,
+			problem.active, len(problem.siblings), problem.siblings[problem.proposed].ID)
+	}
+}
+
+// Check function checks that each cluster has exactly one active
+// configuration. Problems are fixed when --fix flag is used, otherwise user
+// is asked whether to fix them (when confirmation is enabled). Error is
+// returned when any problem remains unfixed.
+func Check(api restapi.API, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("check")
+	fix := flags.Bool("fix", false, "fix all problems without asking")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		err = fmt.Errorf("unexpected arguments: %v", positional)
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	clusters, err := api.ReadListOfClusters()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfClusters))
+		fmt.Println(err)
+		return err
+	}
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+
+	// clusters without configurations are displayed just for information,
+	// they don't make the check fail
+	problems, unconfigured := findActivationProblems(clusters, configurations)
+	if len(unconfigured) > 0 {
+		printUnconfiguredClusters(unconfigured)
+	}
+	if len(problems) == 0 {
+		fmt.Println(colorizer.Blue("All configured clusters have exactly one active configuration"))
+		return nil
+	}
+	printActivationProblems(problems)
+
+	unfixed := 0
+	for _, problem := range problems {
+		if !*fix && !askForConfirmation {
+			unfixed++
+			continue
+		}
+
+		proposed := problem.siblings[problem.proposed].ID
+		if !*fix {
+			question := fmt.Sprintf("Configuration %d will be the only active configuration of cluster %s",
+				proposed, problem.cluster.Name)
+			if !ProceedQuestion(question) {
+				unfixed++
+				continue
+			}
+		}
+
+		_, err := activateExclusively(api, problem.siblings, problem.proposed)
+		if err != nil {
+			fmt.Println(colorizer.Red(CannotActivateConfigurationErrorMessage))
+			fmt.Println(err)
+			unfixed++
+			continue
+		}
+		fmt.Println(colorizer.Blue(fmt.Sprintf("Cluster %s: configuration %d has been", problem.cluster.Name, proposed)),
+			colorizer.Green("activated"))
+	}
+
+	if unfixed > 0 {
+		return errors.New(strconv.Itoa(unfixed) + " clusters do not have exactly one active configuration")
+	}
+	return nil
+}
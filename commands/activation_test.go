@@ -0,0 +1,309 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking exclusive activation of configurations and check
+// command.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/activation_test.html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// singleClusterMock is mocked REST API with just one cluster
+// 00000000-0000-0000-0000-000000000000
+type singleClusterMock struct {
+	configurationStoreMock
+}
+
+// ReadListOfClusters returns just one cluster
+func (api singleClusterMock) ReadListOfClusters() ([]types.Cluster, error) {
+	return []types.Cluster{{ID: 1, Name: "00000000-0000-0000-0000-000000000000"}}, nil
+}
+
+// newSingleClusterMock function constructs mocked REST API with one cluster
+// with given active flags of its three configurations
+func newSingleClusterMock(active ...string) singleClusterMock {
+	api := singleClusterMock{newConfigurationStoreMock()}
+	for i := range active {
+		(*api.configurations)[i].Active = active[i]
+	}
+	return api
+}
+
+// TestActivateClusterConfiguration function checks whether other
+// configurations of the same cluster are disabled.
+func TestActivateClusterConfiguration(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.ActivateClusterConfiguration(api, []string{"2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	expected := "Configuration 2 has been activated\n" +
+		"2 other configurations of cluster 00000000-0000-0000-0000-000000000000 have been disabled\n"
+	if captured != expected {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if strings.Join(*api.calls, ",") != "enable 2,disable 0,disable 1" {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestActivateActiveClusterConfiguration function checks activation of
+// configuration that is already active.
+func TestActivateActiveClusterConfiguration(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.ActivateClusterConfiguration(api, []string{"0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if strings.Join(*api.calls, ",") != "disable 1" {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestActivateClusterConfigurationRevert function checks whether all changes
+// are reverted when any operation fails.
+func TestActivateClusterConfigurationRevert(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+	api.failOn = "disable 1"
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.ActivateClusterConfiguration(api, []string{"2"})
+		if err == nil {
+			t.Fatal("Error is expected")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "configuration 1 can not be disabled") ||
+		!strings.Contains(captured, "original state has been restored") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+
+	// changes need to be reverted in the opposite order
+	expected := "enable 2,disable 0,disable 1,enable 0,disable 2"
+	if strings.Join(*api.calls, ",") != expected {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestActivateClusterConfigurationErrors function checks the error handling
+// in activate configuration command.
+func TestActivateClusterConfigurationErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"no configuration", []string{}, "exactly one configuration needs to be specified"},
+		{"unknown configuration", []string{"42"}, "configuration 42 not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			captured, err := capture.StandardOutput(func() {
+				err := commands.ActivateClusterConfiguration(RestAPIMock{}, tc.args)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
+
+// TestCheckNoProblems function checks the output when each cluster has
+// exactly one active configuration.
+func TestCheckNoProblems(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newSingleClusterMock("0", "1", "0")
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Check(api, []string{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if captured != "All configured clusters have exactly one active configuration\n" {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestCheckReportOnly function checks that problems are just reported when
+// they should not be fixed.
+func TestCheckReportOnly(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Check(api, []string{}, false)
+		if err == nil || err.Error() != "1 clusters do not have exactly one active configuration" {
+			t.Fatal("Unexpected error:", err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	expected := []string{
+		"Clusters without any configuration\n  ID Cluster\n   0 c8590f31-e97e-4b85-b506-c45ce1911a12\n",
+		"   1 00000000-0000-0000-0000-000000000000      2              3 activate 1",
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+	if len(*api.calls) != 0 {
+		t.Fatal("Nothing should be changed:", *api.calls)
+	}
+}
+
+// TestCheckFix function checks whether problems are fixed with --fix flag.
+func TestCheckFix(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	testCases := []struct {
+		name     string
+		active   []string
+		expected string
+	}{
+		{"multiple active", []string{"1", "1", "0"}, "disable 0"},
+		{"none active", []string{"0", "0", "0"}, "enable 2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newSingleClusterMock(tc.active...)
+
+			captured, err := capture.StandardOutput(func() {
+				err := commands.Check(api, []string{"--fix"}, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, "has been activated") {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+			if strings.Join(*api.calls, ",") != tc.expected {
+				t.Fatal("Unexpected calls:", *api.calls)
+			}
+		})
+	}
+}
+
+// TestCheckUnconfiguredClusters function checks that clusters without any
+// configuration are just displayed and the check does not fail because of
+// them.
+func TestCheckUnconfiguredClusters(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+	(*api.configurations)[0].Active = "0"
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Check(api, []string{"--fix"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Clusters without any configuration") ||
+		!strings.HasSuffix(captured, "All configured clusters have exactly one active configuration\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestCheckErrors function checks the error handling in check command.
+func TestCheckErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Check(RestAPIMockErrors{}, []string{}, false)
+		if err == nil {
+			t.Fatal("Error is expected")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Error reading list of clusters") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/apply.html
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/RedHatInsights/insights-operator-cli/declarative"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// manifestFlags structure contains flags shared by plan and apply commands
+type manifestFlags struct {
+	file  string
+	prune bool
+}
+
+// addManifestFlags function registers flags shared by plan and apply
+// commands
+func addManifestFlags(flags *flag.FlagSet) *manifestFlags {
+	var options manifestFlags
+	flags.StringVar(&options.file, "f", "", "manifest with desired state (YAML or JSON)")
+	flags.StringVar(&options.file, "file", "", "manifest with desired state (YAML or JSON)")
+	flags.BoolVar(&options.prune, "prune", false, "delete clusters that are not specified in manifest")
+	return &options
+}
+
+// computePlan function parses arguments, reads manifest and computes plan
+// against the live state. All errors are displayed.
+func computePlan(api restapi.API, command string, args []string) (*declarative.Plan, error) {
+	flags := newFlagSet(command)
+	options := addManifestFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(positional) != 0:
+		return nil, reportInvalidArguments("unexpected arguments: use -f flag to specify manifest")
+	case options.file == "":
+		return nil, reportInvalidArguments("manifest needs to be specified by -f flag")
+	}
+
+	manifest, err := declarative.LoadManifest(options.file)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadManifestErrorMessage))
+		fmt.Println(err)
+		return nil, err
+	}
+
+	plan, err := declarative.ComputePlan(api, manifest, options.prune)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+// actionSymbol function returns colored symbol displayed before action
+func actionSymbol(kind declarative.ActionKind) string {
+	switch kind {
+	case declarative.CreateCluster, declarative.CreateProfile, declarative.CreateConfiguration:
+		return colorizer.Green("+").String()
+	case declarative.EnableConfiguration:
+		return colorizer.Yellow("~").String()
+	default:
+		return colorizer.Red("-").String()
+	}
+}
+
+// printPlan function displays all actions from plan together with summary
+func printPlan(plan *declarative.Plan) {
+	if plan.Empty() {
+		fmt.Println(colorizer.Blue("No changes, controller state matches the manifest"))
+		return
+	}
+
+	fmt.Println(colorizer.Magenta("Planned actions"))
+	for _, action := range plan.Actions {
+		fmt.Println(" ", actionSymbol(action.Kind), action)
+	}
+	fmt.Println()
+	fmt.Printf("Plan: %d to create, %d to enable, %d to disable, %d to delete\n",
+		plan.Count(declarative.CreateCluster, declarative.CreateProfile, declarative.CreateConfiguration),
+		plan.Count(declarative.EnableConfiguration),
+		plan.Count(declarative.DisableConfiguration),
+		plan.Count(declarative.DeleteCluster))
+}
+
+// Plan function displays actions needed to change controller state to the
+// state described by manifest. Nothing is changed.
+func Plan(api restapi.API, args []string) error {
+	plan, err := computePlan(api, "plan", args)
+	if err != nil {
+		return err
+	}
+	printPlan(plan)
+	return nil
+}
+
+// Apply function changes controller state to the state described by
+// manifest. Planned actions are displayed first and they are performed
+// after confirmation.
+func Apply(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	plan, err := computePlan(api, "apply", args)
+	if err != nil {
+		return err
+	}
+	printPlan(plan)
+	if plan.Empty() {
+		return nil
+	}
+	fmt.Println()
+
+	if askForConfirmation {
+		if !ProceedQuestion("Actions listed above will be performed") {
+			return nil
+		}
+	}
+
+	performed := 0
+	err = declarative.Apply(api, plan, username, func(action declarative.Action) {
+		fmt.Println(" ", colorizer.Green("done"), action)
+		performed++
+	})
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotApplyPlanErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	fmt.Println(colorizer.Blue(fmt.Sprintf("Apply complete, %d actions performed", performed)))
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking plan and apply commands.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/apply_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// testManifest describes one new cluster and new active configuration for
+// cluster known to mocked REST API
+const testManifest = `
+clusters:
+  - 00000000-0000-0000-0000-000000000000
+  - 11111111-1111-1111-1111-111111111111
+profiles:
+  - description: watch
+    file: watch.json
+configurations:
+  - cluster: 00000000-0000-0000-0000-000000000000
+    profile: watch
+`
+
+// writeManifest is a helper function that stores manifest together with
+// payload of its profile into temporary directory
+func writeManifest(t *testing.T, manifest string) string {
+	directory := t.TempDir()
+	err := os.WriteFile(filepath.Join(directory, "watch.json"), []byte(`{"watch":[]}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileName := filepath.Join(directory, "manifest.yaml")
+	err = os.WriteFile(fileName, []byte(manifest), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+// TestPlan function checks the plan command.
+func TestPlan(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := writeManifest(t, testManifest)
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Plan(RestAPIMock{}, []string{"-f", fileName})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	expected := []string{
+		"+ create cluster 11111111-1111-1111-1111-111111111111",
+		`+ create profile "watch"`,
+		`+ create configuration for cluster 00000000-0000-0000-0000-000000000000 (profile "watch")`,
+		"- disable configuration 0 for cluster 00000000-0000-0000-0000-000000000000",
+		"- disable configuration 1 for cluster 00000000-0000-0000-0000-000000000000",
+		"Plan: 3 to create, 0 to enable, 2 to disable, 0 to delete",
+	}
+	for _, line := range expected {
+		if !strings.Contains(captured, line) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestPlanNoChanges function checks the plan command when controller state
+// matches the manifest.
+func TestPlanNoChanges(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := writeManifest(t, "clusters: [00000000-0000-0000-0000-000000000000]")
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Plan(RestAPIMock{}, []string{"--file", fileName})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "No changes, controller state matches the manifest") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestPlanInvalidArguments function checks the plan command when manifest
+// is not specified or it can't be read.
+func TestPlanInvalidArguments(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	arguments := map[string][]string{
+		commands.InvalidCommandArgumentsErrorMessage: {},
+		commands.CannotReadManifestErrorMessage:      {"-f", "/does/not/exist.yaml"},
+	}
+	for message, args := range arguments {
+		captured, err := capture.StandardOutput(func() {
+			err := commands.Plan(RestAPIMock{}, args)
+			if err == nil {
+				t.Fatal("Error is expected to be returned")
+			}
+		})
+
+		// check if capture was done correctly
+		checkCapturedOutput(t, captured, err)
+
+		if !strings.Contains(captured, message) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestPlanError function checks the plan command when REST API returns an
+// error.
+func TestPlanError(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := writeManifest(t, testManifest)
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Plan(RestAPIMockErrors{}, []string{"-f", fileName})
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.ErrorCommunicationWithServiceErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestApply function checks that all planned actions are performed in
+// order.
+func TestApply(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := writeManifest(t, testManifest)
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Apply(api, "tester", []string{"-f", fileName}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "Apply complete, 5 actions performed") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+
+	// new configuration is enabled before the others are disabled
+	expected := []string{
+		`add profile watch {"watch":[]}`,
+		`add 00000000-0000-0000-0000-000000000000 Applied from manifest watch {"watch":[]}`,
+		"enable 3",
+		"disable 0",
+		"disable 1",
+	}
+	if strings.Join(*api.calls, "\n") != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestApplyFailure function checks that apply stops on the first failed
+// action.
+func TestApplyFailure(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := writeManifest(t, testManifest)
+	api := newConfigurationStoreMock()
+	api.failOn = "disable 0"
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Apply(api, "tester", []string{"-f", fileName}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.CannotApplyPlanErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if (*api.calls)[len(*api.calls)-1] != "disable 0" {
+		t.Fatal("Apply should stop on the first failure:", *api.calls)
+	}
+}
+
+// TestApplyNotLoggedIn function checks the apply command when user is not
+// logged in.
+func TestApplyNotLoggedIn(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Apply(RestAPIMock{}, "", []string{"-f", "manifest.yaml"}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "Not logged in") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/arguments.html
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// stringList represents flag that can be specified more times, all values
+// are collected
+type stringList []string
+
+// String method returns all values of flag
+func (list *stringList) String() string {
+	return strings.Join(*list, ", ")
+}
+
+// Set method adds new value of flag
+func (list *stringList) Set(value string) error {
+	*list = append(*list, value)
+	return nil
+}
+
+// newFlagSet function constructs new set of flags for command with given
+// name. Errors are not fatal, they are just reported to caller.
+func newFlagSet(command string) *flag.FlagSet {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	flags.SetOutput(os.Stdout)
+	return flags
+}
+
+// parseArguments function parses flags that can be mixed with positional
+// arguments (for example "rollback cluster 42 --steps 2"). Positional
+// arguments are returned in the same order as specified by user.
+func parseArguments(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		// flag package stops parsing on the first positional argument
+		err := flags.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseCommandArguments function parses command arguments and displays
+// error message when arguments are not correct
+func parseCommandArguments(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional, err := parseArguments(flags, args)
+	if err != nil {
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		return nil, err
+	}
+	return positional, nil
+}
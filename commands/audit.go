@@ -0,0 +1,126 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/audit.html
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RedHatInsights/insights-operator-cli/audit"
+)
+
+// layout of timestamps displayed in audit log
+const auditTimestampLayout = "2006-01-02 15:04:05"
+
+// auditLog contains log of all mutating operations, nil when the operations
+// are not recorded
+var auditLog *audit.Log
+
+// SetAuditLog function sets log that contains records of all mutating
+// operations.
+func SetAuditLog(log *audit.Log) {
+	auditLog = log
+}
+
+// printAuditRecord function displays one record of audit log, command line
+// and controller are displayed in details
+func printAuditRecord(record audit.Record, details bool) {
+	result := colorizer.Green(record.Result)
+	if record.Result != audit.ResultOK {
+		result = colorizer.Red(record.Result)
+	}
+	fmt.Printf("%-19s  %-12s %-12s %-22s %-6s %s\n", record.Timestamp.UTC().Format(auditTimestampLayout),
+		record.OSUser, record.Username, record.Operation, result, record.FormatArguments())
+	if record.Error != "" {
+		fmt.Println("    error:     ", colorizer.Red(record.Error))
+	}
+	if details {
+		fmt.Println("    command:   ", record.Command)
+		fmt.Println("    controller:", record.Controller)
+	}
+}
+
+// Audit function displays records of audit log that match the filters
+// specified by flags: --user, --os-user, --operation, --id, --cluster,
+// --since and --failed. With --last flag just the given number of the most
+// recent records is displayed.
+func Audit(args []string) error {
+	flags := newFlagSet("audit")
+	var filter audit.Filter
+	flags.StringVar(&filter.Username, "user", "", "select operations performed by logged in user")
+	flags.StringVar(&filter.OSUser, "os-user", "", "select operations performed by OS user")
+	flags.StringVar(&filter.Operation, "operation", "", "select operations of given kind, for example \"disable configuration\"")
+	flags.StringVar(&filter.ID, "id", "", "select operations with object of given ID")
+	flags.StringVar(&filter.Cluster, clusterFlag, "", "select operations for given cluster")
+	flags.BoolVar(&filter.FailedOnly, "failed", false, "select failed operations only")
+	since := flags.String("since", "", "select operations performed in given time, for example 7d")
+	last := flags.Int("last", 0, "display given number of the most recent operations only")
+	details := flags.Bool("details", false, "display command line and controller URL")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 0:
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional, " "))
+	case *last < 0:
+		return reportInvalidArguments("number of operations can not be negative")
+	}
+	if *since != "" {
+		age, err := parseAge(*since)
+		if err != nil {
+			return reportInvalidArguments(err.Error())
+		}
+		filter.Since = currentTime().Add(-age)
+	}
+
+	if auditLog == nil {
+		fmt.Println(colorizer.Red(CannotReadAuditLogErrorMessage))
+		fmt.Println("audit log is not configured")
+		return errors.New("audit log is not configured")
+	}
+	records, err := auditLog.Read()
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadAuditLogErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	selected := audit.Select(records, filter)
+	if *last > 0 && len(selected) > *last {
+		selected = selected[len(selected)-*last:]
+	}
+	if len(selected) == 0 {
+		fmt.Println(colorizer.Blue("No operations match the selection"))
+		return nil
+	}
+
+	fmt.Println(colorizer.Magenta("Audit log"))
+	fmt.Printf("%-19s  %-12s %-12s %-22s %-6s %s\n", "Timestamp (UTC)", "OS user", "Username", "Operation", "Result", "Arguments")
+	for _, record := range selected {
+		printAuditRecord(record, *details)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking display of local audit log.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/audit_test.html
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/audit"
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// useAuditLog is a helper function that configures audit log stored in
+// temporary directory and filled by given records, the returned function
+// removes the log
+func useAuditLog(t *testing.T, records ...audit.Record) func() {
+	log := audit.NewLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	for _, record := range records {
+		if err := log.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commands.SetAuditLog(log)
+	return func() {
+		commands.SetAuditLog(nil)
+	}
+}
+
+// auditRecords returns records of three operations performed during the
+// last ten days, the current time is 2020-01-10 12:00
+func auditRecords() []audit.Record {
+	return []audit.Record{
+		{Timestamp: time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC), OSUser: "alice", Username: "tester",
+			Controller: "http://localhost:8080/api/v1/", Command: "add cluster cluster-a",
+			Operation: audit.AddCluster, Arguments: map[string]string{"cluster": "cluster-a"}, Result: audit.ResultOK},
+		{Timestamp: time.Date(2020, 1, 9, 9, 0, 0, 0, time.UTC), OSUser: "bob", Username: "admin",
+			Controller: "http://localhost:8080/api/v1/", Command: "disable 42",
+			Operation: audit.DisableConfiguration, Arguments: map[string]string{"id": "42"},
+			Result: audit.ResultError, Error: "404 Not Found"},
+		{Timestamp: time.Date(2020, 1, 10, 10, 0, 0, 0, time.UTC), OSUser: "alice", Username: "tester",
+			Controller: "http://localhost:8080/api/v1/", Command: "request must-gather cluster-a",
+			Operation: audit.AddTrigger, Arguments: map[string]string{"cluster": "cluster-a", "type": "must-gather"},
+			Result: audit.ResultOK},
+	}
+}
+
+// runAudit is a helper function that runs audit command and returns its
+// output and error
+func runAudit(t *testing.T, args ...string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.SetCurrentTime(time.Date(2020, 1, 10, 12, 0, 0, 0, time.UTC))()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.Audit(args)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// TestAuditAllRecords function checks that all records are displayed when no
+// filter is specified.
+func TestAuditAllRecords(t *testing.T) {
+	defer useAuditLog(t, auditRecords()...)()
+
+	captured, err := runAudit(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"Audit log", "2020-01-01 08:00:00", `cluster="cluster-a"`,
+		"disable configuration", "404 Not Found", `type="must-gather"`} {
+		if !strings.Contains(captured, expected) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+	if strings.Contains(captured, "command:") {
+		t.Fatal("Details should not be displayed:\n", captured)
+	}
+}
+
+// TestAuditFilters function checks that records are selected by flags.
+func TestAuditFilters(t *testing.T) {
+	defer useAuditLog(t, auditRecords()...)()
+
+	testCases := []struct {
+		args     []string
+		expected []string
+	}{
+		{[]string{"--os-user", "bob"}, []string{"disable configuration"}},
+		{[]string{"--user", "tester"}, []string{"add cluster", "add trigger"}},
+		{[]string{"--failed"}, []string{"disable configuration"}},
+		{[]string{"--since", "2d"}, []string{"disable configuration", "add trigger"}},
+		{[]string{"--last", "1"}, []string{"add trigger"}},
+		{[]string{"--id", "42"}, []string{"disable configuration"}},
+	}
+	operations := []string{"add cluster", "disable configuration", "add trigger"}
+
+	for _, testCase := range testCases {
+		captured, err := runAudit(t, testCase.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, operation := range operations {
+			expected := false
+			for _, selected := range testCase.expected {
+				expected = expected || selected == operation
+			}
+			if strings.Contains(captured, operation) != expected {
+				t.Fatal("Unexpected output for", testCase.args, ":\n", captured)
+			}
+		}
+	}
+}
+
+// TestAuditDetails function checks that command line and controller are
+// displayed with --details flag.
+func TestAuditDetails(t *testing.T) {
+	defer useAuditLog(t, auditRecords()...)()
+
+	captured, err := runAudit(t, "--details", "--last", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "command:    request must-gather cluster-a") ||
+		!strings.Contains(captured, "controller: http://localhost:8080/api/v1/") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAuditNoMatch function checks the output when no record match the
+// selection.
+func TestAuditNoMatch(t *testing.T) {
+	defer useAuditLog(t, auditRecords()...)()
+
+	captured, err := runAudit(t, "--user", "nobody")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "No operations match the selection") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAuditNotConfigured function checks that error is reported when audit
+// log is not configured.
+func TestAuditNotConfigured(t *testing.T) {
+	commands.SetAuditLog(nil)
+
+	captured, err := runAudit(t)
+	if err == nil || !strings.Contains(captured, commands.CannotReadAuditLogErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAuditInvalidArguments function checks that invalid arguments are
+// refused.
+func TestAuditInvalidArguments(t *testing.T) {
+	defer useAuditLog(t)()
+
+	for _, args := range [][]string{{"--since", "soon"}, {"--last", "-1"}, {"unexpected"}} {
+		captured, err := runAudit(t, args...)
+		if err == nil || !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/backup.html
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/backup"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// defaultBackupFile is name of archive used when --out flag is not specified
+const defaultBackupFile = "backup.tar.gz"
+
+// printBackupCounts function displays number of objects of each kind
+func printBackupCounts(counts map[string]int) {
+	for _, kind := range []string{backup.ClusterKind, backup.ProfileKind, backup.ConfigurationKind, backup.TriggerKind} {
+		fmt.Printf("%-16s %d\n", kind+"s:", counts[kind])
+	}
+}
+
+// Export function reads the whole state of controller service and stores it
+// into versioned archive with manifest and checksums.
+func Export(api restapi.API, args []string) error {
+	flags := newFlagSet("export")
+	out := flags.String("out", defaultBackupFile, "name of archive to be created")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return reportInvalidArguments("unexpected arguments: use --out flag to specify archive name")
+	}
+
+	snapshot, err := backup.Collect(api)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// archive contains configurations of all clusters, so it should be
+	// readable by owner only
+	file, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotWriteBackupErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	err = backup.Write(file, snapshot, time.Now().UTC())
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotWriteBackupErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	fmt.Println(colorizer.Blue("State of controller has been exported into " + *out))
+	printBackupCounts(snapshot.Counts())
+	return nil
+}
+
+// printRestoreReport function displays result of import operation
+func printRestoreReport(report *backup.Report) {
+	fmt.Println(colorizer.Magenta("Created objects"))
+	printBackupCounts(report.Created)
+
+	if len(report.Remapped) > 0 {
+		fmt.Println()
+		fmt.Println(colorizer.Magenta("Remapped IDs"))
+		fmt.Printf("%-16s %6s %6s\n", "Kind", "Old ID", "New ID")
+		for _, remap := range report.Remapped {
+			fmt.Printf("%-16s %6d %6d\n", remap.Kind, remap.OldID, remap.NewID)
+		}
+	}
+
+	if len(report.Conflicts) > 0 {
+		fmt.Println()
+		fmt.Println(colorizer.Magenta("Conflicts"))
+		for _, conflict := range report.Conflicts {
+			fmt.Println(colorizer.Yellow(conflict))
+		}
+	}
+
+	if len(report.Errors) > 0 {
+		fmt.Println()
+		fmt.Println(colorizer.Magenta("Errors"))
+		for _, failure := range report.Errors {
+			fmt.Println(colorizer.Red(failure))
+		}
+	}
+}
+
+// Import function replays archive created by Export command into controller
+// service. Objects are created with new IDs, objects that already exist in
+// the controller are reported as conflicts.
+func Import(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("import")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return reportInvalidArguments("exactly one archive needs to be specified")
+	}
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	file, err := os.Open(positional[0])
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadBackupErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	snapshot, manifest, err := backup.Read(file)
+	_ = file.Close()
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadBackupErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	fmt.Println(colorizer.Magenta("Archive created at " + manifest.CreatedAt))
+	printBackupCounts(manifest.Counts)
+	fmt.Println()
+
+	if askForConfirmation {
+		if !ProceedQuestion("All objects from archive will be created in controller") {
+			return nil
+		}
+	}
+
+	report, err := backup.Restore(api, snapshot, username)
+	printRestoreReport(report)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%d operations failed during import", len(report.Errors))
+	}
+
+	fmt.Println()
+	fmt.Println(colorizer.Blue("Archive " + positional[0] + " has been imported"))
+	return nil
+}
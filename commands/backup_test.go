@@ -0,0 +1,180 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking export and import of controller state.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/backup_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// exportArchive is a helper function that exports state of mocked REST API
+// into temporary file and returns its name
+func exportArchive(t *testing.T) string {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Export(RestAPIMock{}, []string{"--out", fileName})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "State of controller has been exported into "+fileName) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	info, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatal("Archive should be readable by owner only", info.Mode())
+	}
+	return fileName
+}
+
+// TestExport function checks the export command.
+func TestExport(t *testing.T) {
+	exportArchive(t)
+}
+
+// TestExportError function checks the export command when REST API
+// returns an error.
+func TestExportError(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	fileName := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Export(RestAPIMockErrors{}, []string{"--out", fileName})
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.ErrorCommunicationWithServiceErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if _, err := os.Stat(fileName); err == nil {
+		t.Fatal("Archive should not be created")
+	}
+}
+
+// TestImportIntoSameController function checks that import of archive into
+// the same controller reports conflicts only.
+func TestImportIntoSameController(t *testing.T) {
+	fileName := exportArchive(t)
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Import(RestAPIMock{}, "tester", []string{fileName}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	for _, expected := range []string{"Archive created at", "Conflicts", "already exists",
+		"Archive " + fileName + " has been imported"} {
+		if !strings.Contains(captured, expected) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestImportErrors function checks the import command when REST API returns
+// an error.
+func TestImportErrors(t *testing.T) {
+	fileName := exportArchive(t)
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Import(RestAPIMockErrors{}, "tester", []string{fileName}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.ErrorCommunicationWithServiceErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestImportMissingFile function checks the import command for archive
+// that does not exist.
+func TestImportMissingFile(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Import(RestAPIMock{}, "tester", []string{"/does/not/exist.tar.gz"}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.CannotReadBackupErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestImportNotLoggedIn function checks the import command when user is
+// not logged in.
+func TestImportNotLoggedIn(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Import(RestAPIMock{}, "", []string{"backup.tar.gz"}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "Not logged in") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/bulk.html
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+)
+
+// default settings for bulk operations
+const (
+	defaultBulkWorkers = 4
+	defaultBulkRate    = 10
+
+	// maximum number of IDs that can be specified by list of IDs
+	maxIDListSize = 10000
+
+	// maximum rate for which the interval between operations is not zero
+	maxBulkRate = int(time.Second)
+)
+
+// bulkOptions represents settings of bulk operation that can be changed by
+// user via command flags
+type bulkOptions struct {
+	// number of REST API calls performed concurrently
+	workers int
+
+	// maximum number of REST API calls per second, zero means no limit
+	rate bulkRate
+}
+
+// bulkRate represents maximum number of operations per second, the value is
+// checked when the flag is parsed
+type bulkRate int
+
+// String method returns textual representation of rate
+func (rate *bulkRate) String() string {
+	return strconv.Itoa(int(*rate))
+}
+
+// Set method parses the rate specified by user
+func (rate *bulkRate) Set(value string) error {
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid rate: %s", value)
+	}
+	if parsed < 0 || parsed > maxBulkRate {
+		return fmt.Errorf("rate needs to be between 0 and %d", maxBulkRate)
+	}
+	*rate = bulkRate(parsed)
+	return nil
+}
+
+// bulkResult represents result of operation performed for one item
+type bulkResult struct {
+	item string
+	err  error
+}
+
+// addBulkFlags function registers flags common for all bulk operations
+func addBulkFlags(flags *flag.FlagSet) *bulkOptions {
+	options := bulkOptions{rate: defaultBulkRate}
+	flags.IntVar(&options.workers, "workers", defaultBulkWorkers,
+		"number of operations performed concurrently")
+	flags.Var(&options.rate, "rate",
+		"maximum number of operations per second (0 = unlimited)")
+	return &options
+}
+
+// parseIDList function parses list of IDs in form "3,5,9-20" into list of
+// separate IDs. Each ID is returned just once, in the order specified by user.
+// Lists that would expand to more than maxIDListSize IDs are refused.
+func parseIDList(list string) ([]string, error) {
+	var ids []string
+	seen := map[int]bool{}
+
+	add := func(id int) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, strconv.Itoa(id))
+		}
+	}
+
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		// simple ID
+		from, to, isRange := strings.Cut(part, "-")
+		if !isRange {
+			id, err := strconv.Atoi(part)
+			if err != nil || id < 0 {
+				return nil, fmt.Errorf("invalid ID: %s", part)
+			}
+			add(id)
+			continue
+		}
+
+		// range of IDs
+		first, err1 := strconv.Atoi(from)
+		last, err2 := strconv.Atoi(to)
+		if err1 != nil || err2 != nil || first < 0 || first > last {
+			return nil, fmt.Errorf("invalid range of IDs: %s", part)
+		}
+		if last-first >= maxIDListSize-len(ids) {
+			return nil, fmt.Errorf("too many IDs, at most %d IDs can be specified: %s", maxIDListSize, part)
+		}
+		// count the offset so the loop ends even for the highest ID
+		for offset := 0; offset <= last-first; offset++ {
+			add(first + offset)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no ID specified")
+	}
+	return ids, nil
+}
+
+// runBulkOperation function calls the operation for all items using bounded
+// pool of workers. Number of calls per second is limited on client side when
+// rate is set. Results are returned in the same order as items.
+func runBulkOperation(items []string, options bulkOptions, operation func(item string) error) []bulkResult {
+	results := make([]bulkResult, len(items))
+
+	workers := options.workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	// client side rate limiting
+	var limiter <-chan time.Time
+	if options.rate > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(options.rate))
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	// indexes of items to be processed
+	queue := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				if limiter != nil {
+					<-limiter
+				}
+				results[i] = bulkResult{
+					item: items[i],
+					err:  operation(items[i]),
+				}
+			}
+		}()
+	}
+
+	for i := range items {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	return results
+}
+
+// reportBulkResults function displays results of bulk operation for all items
+// and returns an error when any operation failed
+func reportBulkResults(results []bulkResult, subject string, done aurora.Value) error {
+	failed := 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			fmt.Println(colorizer.Red(subject+result.item+":"), result.err)
+		} else {
+			fmt.Println(colorizer.Blue(subject+result.item+hasBeenMessage), done)
+		}
+	}
+
+	if len(results) > 1 {
+		fmt.Printf("%d succeeded, %d failed\n", len(results)-failed, failed)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d operations failed", failed, len(results))
+	}
+	return nil
+}
+
+// selectBulkItems function returns IDs specified by user or IDs selected by
+// the provided selector. Exactly one of these sources needs to be used.
+func selectBulkItems(positional []string, selectorUsed bool, selector func() ([]string, error)) ([]string, error) {
+	switch {
+	case len(positional) > 1:
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(positional[1:], " "))
+	case len(positional) == 1 && selectorUsed:
+		return nil, fmt.Errorf("list of IDs can not be combined with selector")
+	case len(positional) == 1:
+		return parseIDList(positional[0])
+	case selectorUsed:
+		return selector()
+	default:
+		return nil, fmt.Errorf("list of IDs or selector needs to be specified")
+	}
+}
+
+// confirmBulkOperation function asks user for confirmation of operation that
+// is to be performed for more items
+func confirmBulkOperation(items []string, question string, askForConfirmation bool) bool {
+	if !askForConfirmation || len(items) <= 1 {
+		return true
+	}
+	return ProceedQuestion(fmt.Sprintf(question, len(items), strings.Join(items, ",")))
+}
+
+// bulkCommand represents command that performs the same operation for more
+// items (triggers, configurations)
+type bulkCommand struct {
+	// subject displayed in front of each item ID in results
+	subject string
+
+	// message displayed for successfully processed items
+	done aurora.Value
+
+	// question used to confirm the operation, it should contain
+	// placeholders for number of items and list of their IDs
+	question string
+
+	// operation to be performed for each item
+	operation func(id string) error
+}
+
+// runBulkCommand function selects the items, asks for confirmation if needed,
+// performs the operation for all selected items and reports results. Error is
+// returned when items can't be selected or when any operation failed.
+func runBulkCommand(command bulkCommand, positional []string, selectorUsed bool,
+	selector func() ([]string, error), options bulkOptions, askForConfirmation bool) error {
+	ids, err := selectBulkItems(positional, selectorUsed, selector)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotSelectItemsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// selector might not find any item, which is not an error
+	if len(ids) == 0 {
+		fmt.Println(colorizer.Blue(nothingSelected))
+		return nil
+	}
+
+	if !confirmBulkOperation(ids, command.question, askForConfirmation) {
+		return nil
+	}
+
+	results := runBulkOperation(ids, options, command.operation)
+	return reportBulkResults(results, command.subject, command.done)
+}
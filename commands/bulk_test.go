@@ -0,0 +1,243 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking bulk operations with triggers and configurations.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/bulk_test.html
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// TestParseIDList checks parsing of lists of IDs
+func TestParseIDList(t *testing.T) {
+	ids, err := commands.ParseIDList("3,5,9-12,5")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"3", "5", "9", "10", "11", "12"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Fatal("Unexpected list of IDs:", ids)
+	}
+}
+
+// TestParseIDListImproperInput checks that improper lists of IDs are refused
+func TestParseIDListImproperInput(t *testing.T) {
+	improperLists := []string{"", ",", "x", "1,x", "5-3", "1-x", "-1",
+		"1-1000000000", "1-5000,5001-10001", fmt.Sprintf("0-%d", math.MaxInt)}
+
+	for _, list := range improperLists {
+		_, err := commands.ParseIDList(list)
+		if err == nil {
+			t.Fatal("Error is expected for list:", list)
+		}
+	}
+}
+
+// TestParseIDListHighestIDs checks that range ending with the highest ID is
+// expanded properly
+func TestParseIDListHighestIDs(t *testing.T) {
+	ids, err := commands.ParseIDList(fmt.Sprintf("%d-%d", math.MaxInt-1, math.MaxInt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[1] != strconv.Itoa(math.MaxInt) {
+		t.Fatal("Unexpected list of IDs:", ids)
+	}
+}
+
+// TestDeleteTriggersImproperRate checks that rate for which the interval
+// between operations would be zero is refused
+func TestDeleteTriggersImproperRate(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.DeleteTriggers(RestAPIMock{}, []string{"1", "--rate", "2000000000"}, false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDeleteTriggersList checks that all triggers from the list are deleted
+func TestDeleteTriggersList(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.DeleteTriggers(RestAPIMock{}, []string{"1-3", "--rate", "0"}, false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status != nil {
+		t.Fatal("Unexpected error:", status)
+	}
+	for _, expected := range []string{
+		"Trigger 1 has been deleted",
+		"Trigger 2 has been deleted",
+		"Trigger 3 has been deleted",
+		"3 succeeded, 0 failed"} {
+		if !strings.Contains(captured, expected) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestDeleteTriggersErrorHandling checks that failures are reported
+func TestDeleteTriggersErrorHandling(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.DeleteTriggers(RestAPIMockErrors{}, []string{"1,2"}, false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.Contains(captured, "Trigger 1: DeleteTrigger error") ||
+		!strings.Contains(captured, "0 succeeded, 2 failed") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDeleteTriggersAllAcked checks selection of acknowledged triggers
+func TestDeleteTriggersAllAcked(t *testing.T) {
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		_ = commands.DeleteTriggers(RestAPIMock{}, []string{"--all-acked"}, false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	// just triggers #1 and #3 are acknowledged in mocked data
+	if !strings.Contains(captured, "Trigger 1 has been deleted") ||
+		!strings.Contains(captured, "Trigger 3 has been deleted") ||
+		strings.Contains(captured, "Trigger 0 ") ||
+		strings.Contains(captured, "Trigger 2 ") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDeactivateTriggersAllAcked checks that no trigger is selected when all
+// acknowledged triggers are already deactivated
+func TestDeactivateTriggersAllAcked(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.DeactivateTriggers(RestAPIMock{}, []string{"--all-acked"})
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status != nil {
+		t.Fatal("Unexpected error:", status)
+	}
+	if !strings.HasPrefix(captured, "No items match the selection") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDeactivateTriggersForCluster checks selection of triggers by cluster
+func TestDeactivateTriggersForCluster(t *testing.T) {
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		_ = commands.DeactivateTriggers(RestAPIMock{}, []string{"--cluster", "ffffffff-ffff-ffff-ffff-ffffffffffff"})
+	})
+	checkCapturedOutput(t, captured, err)
+
+	// just trigger #0 is active for given cluster
+	if !strings.HasPrefix(captured, "Trigger 0 has been deactivated") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestActivateTriggersImproperArguments checks that list of IDs can't be
+// combined with selector
+func TestActivateTriggersImproperArguments(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.ActivateTriggers(RestAPIMock{}, []string{"1", "--all-acked"})
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.HasPrefix(captured, "Can not select items for the operation") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDisableClusterConfigurationsForCluster checks selection of
+// configurations by cluster
+func TestDisableClusterConfigurationsForCluster(t *testing.T) {
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		_ = commands.DisableClusterConfigurations(RestAPIMock{}, []string{"--cluster", "1"})
+	})
+	checkCapturedOutput(t, captured, err)
+
+	// configurations #0 and #1 are active for given cluster
+	if !strings.Contains(captured, "Configuration 0 has been disabled") ||
+		!strings.Contains(captured, "Configuration 1 has been disabled") ||
+		strings.Contains(captured, "Configuration 2 ") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestEnableClusterConfigurationsSelectorError checks that error during
+// selection of configurations is reported
+func TestEnableClusterConfigurationsSelectorError(t *testing.T) {
+	configureColorizer()
+
+	var status error
+	captured, err := capture.StandardOutput(func() {
+		status = commands.EnableClusterConfigurations(RestAPIMockErrors{}, []string{"--cluster", "1"})
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if status == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.Contains(captured, "Error reading list of clusters") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/clone.html
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// editFlag is name of flag used to open the cloned document in text editor
+const editFlag = "edit"
+
+// editCopy function optionally opens the copy of document in text editor.
+// The copy is returned unchanged if it should not be edited or if nothing
+// has been changed in editor.
+func editCopy(document []byte, pattern string, edit bool) ([]byte, error) {
+	if !edit {
+		return document, nil
+	}
+	edited, err := editJSONDocument(document, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if edited == nil {
+		return document, nil
+	}
+	return edited, nil
+}
+
+// reportInvalidArguments function displays error message about invalid
+// command arguments and returns the error
+func reportInvalidArguments(message string) error {
+	err := errors.New(message)
+	fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+	fmt.Println(err)
+	return err
+}
+
+// CloneProfile function creates new configuration profile as a copy of
+// profile selected by its ID. The copy can be edited in text editor before
+// it is created (--edit flag). User is asked before the profile is created
+// when confirmation is enabled.
+func CloneProfile(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("clone profile")
+	description := flags.String("description", "", "description of the new configuration profile")
+	edit := flags.Bool(editFlag, false, "edit the new configuration profile in text editor")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return reportInvalidArguments("exactly one configuration profile needs to be specified")
+	}
+	profileID := positional[0]
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	profile, err := api.ReadConfigurationProfile(profileID)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingConfigurationProfile))
+		fmt.Println(err)
+		return err
+	}
+	if *description == "" {
+		*description = "Copy of " + profile.Description
+	}
+
+	configuration, err := editCopy([]byte(profile.Configuration), "profile-*.json", *edit)
+	if err != nil {
+		return err
+	}
+
+	if askForConfirmation {
+		if !ProceedQuestion("Configuration profile " + profileID + " will be cloned") {
+			return nil
+		}
+	}
+
+	// try to add configuration profile and display error when something
+	// wrong happens
+	err = api.AddConfigurationProfile(username, *description, configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, configuration profile has been created
+	fmt.Println(colorizer.Blue("Configuration profile " + profileID + " has been cloned"))
+	return nil
+}
+
+// CloneClusterConfiguration function creates new cluster configuration for
+// the cluster specified by --to-cluster flag as a copy of configuration
+// selected by its ID. Description of the original configuration is used
+// for the new one. The copy can be edited in text editor before it is
+// created (--edit flag). User is asked before the configuration is created
+// when confirmation is enabled.
+func CloneClusterConfiguration(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("clone configuration")
+	toCluster := flags.String("to-cluster", "", "cluster for the new configuration")
+	reason := flags.String("reason", "", "reason for the new configuration")
+	edit := flags.Bool(editFlag, false, "edit the new configuration in text editor")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 1:
+		return reportInvalidArguments("exactly one configuration needs to be specified")
+	case *toCluster == "":
+		return reportInvalidArguments("target cluster needs to be specified by --to-cluster flag")
+	}
+	configurationID := positional[0]
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	cluster, ok := resolveClusterOrReport(api, *toCluster)
+	if !ok {
+		return errors.New(CannotResolveClusterErrorMessage)
+	}
+
+	// profile is not part of configuration payload
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+	original, err := findConfiguration(configurations, configurationID)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingClusterConfiguration))
+		fmt.Println(err)
+		return err
+	}
+
+	profile, err := api.ReadConfigurationProfile(original.Configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingConfigurationProfile))
+		fmt.Println(err)
+		return err
+	}
+
+	payload, err := readConfigurationPayload(api, original.ID)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingClusterConfiguration))
+		fmt.Println(err)
+		return err
+	}
+
+	configuration, err := editCopy([]byte(payload), "configuration-*.json", *edit)
+	if err != nil {
+		return err
+	}
+
+	if *reason == "" {
+		*reason = fmt.Sprintf("Clone of configuration %d from cluster %s", original.ID, original.Cluster)
+	}
+
+	if askForConfirmation {
+		if !ProceedQuestion("Configuration " + configurationID + " will be cloned to cluster " + cluster.Name) {
+			return nil
+		}
+	}
+
+	// try to add cluster configuration and display error if something
+	// wrong happens
+	err = api.AddClusterConfiguration(username, cluster.Name, *reason, profile.Description, configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, configuration has been created
+	fmt.Println(colorizer.Blue(configurationChangeMsg + configurationID + " has been cloned to cluster " + cluster.Name))
+	return nil
+}
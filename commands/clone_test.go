@@ -0,0 +1,153 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking cloning of profiles and configurations.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/clone_test.html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// runClone is a helper function that runs clone command and checks the
+// captured output and recorded calls
+func runClone(t *testing.T, clone func(restapi.API, string, []string, bool) error, args []string,
+	expectedOutput, expectedCall string) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := clone(api, "tester", args, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, expectedOutput) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if strings.Join(*api.calls, "\n") != expectedCall {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestCloneProfile function checks cloning of configuration profile.
+func TestCloneProfile(t *testing.T) {
+	runClone(t, commands.CloneProfile, []string{"0"},
+		"Configuration profile 0 has been cloned",
+		"add profile Copy of empty configuration *configuration*")
+}
+
+// TestCloneProfileWithDescription function checks cloning of configuration
+// profile with new description.
+func TestCloneProfileWithDescription(t *testing.T) {
+	runClone(t, commands.CloneProfile, []string{"0", "--description", "new one"},
+		"Configuration profile 0 has been cloned",
+		"add profile new one *configuration*")
+}
+
+// TestCloneProfileEdit function checks cloning of configuration profile
+// that is edited in text editor.
+func TestCloneProfileEdit(t *testing.T) {
+	setEditor(t, `printf "{}" > "$1"`)
+	runClone(t, commands.CloneProfile, []string{"0", "--edit"},
+		"+ {}",
+		"add profile Copy of empty configuration {}")
+}
+
+// TestCloneProfileEditNoChanges function checks that unchanged copy is
+// created when nothing is changed in text editor.
+func TestCloneProfileEditNoChanges(t *testing.T) {
+	setEditor(t, "true")
+	runClone(t, commands.CloneProfile, []string{"0", "--edit"},
+		"Configuration profile 0 has been cloned",
+		"add profile Copy of empty configuration *configuration*")
+}
+
+// TestCloneClusterConfiguration function checks cloning of configuration to
+// another cluster.
+func TestCloneClusterConfiguration(t *testing.T) {
+	runClone(t, commands.CloneClusterConfiguration, []string{"0", "--to-cluster", "c859"},
+		"Configuration 0 has been cloned to cluster c8590f31-e97e-4b85-b506-c45ce1911a12",
+		"add c8590f31-e97e-4b85-b506-c45ce1911a12 "+
+			"Clone of configuration 0 from cluster 00000000-0000-0000-0000-000000000000 "+
+			"empty configuration configuration#0")
+}
+
+// TestCloneClusterConfigurationEdit function checks cloning of configuration
+// that is edited in text editor.
+func TestCloneClusterConfigurationEdit(t *testing.T) {
+	setEditor(t, `printf "{\"watch\": []}" > "$1"`)
+	runClone(t, commands.CloneClusterConfiguration, []string{"0", "--to-cluster", "ffff", "--reason", "test", "--edit"},
+		"has been cloned",
+		`add ffffffff-ffff-ffff-ffff-ffffffffffff test empty configuration {"watch": []}`)
+}
+
+// TestCloneErrors function checks the error handling in clone commands.
+func TestCloneErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	testCases := []struct {
+		name     string
+		clone    func(restapi.API, string, []string, bool) error
+		api      restapi.API
+		username string
+		args     []string
+		expected string
+	}{
+		{"no profile", commands.CloneProfile, RestAPIMock{}, "tester", []string{}, "exactly one configuration profile needs to be specified"},
+		{"profile not logged in", commands.CloneProfile, RestAPIMock{}, "", []string{"0"}, "Not logged in"},
+		{"profile read error", commands.CloneProfile, RestAPIMockErrors{}, "tester", []string{"0"}, "Error reading configuration profile"},
+		{"no configuration", commands.CloneClusterConfiguration, RestAPIMock{}, "tester", []string{"--to-cluster", "1"}, "exactly one configuration needs to be specified"},
+		{"no target cluster", commands.CloneClusterConfiguration, RestAPIMock{}, "tester", []string{"0"}, "target cluster needs to be specified by --to-cluster flag"},
+		{"configuration not logged in", commands.CloneClusterConfiguration, RestAPIMock{}, "", []string{"0", "--to-cluster", "1"}, "Not logged in"},
+		{"unknown cluster", commands.CloneClusterConfiguration, RestAPIMock{}, "tester", []string{"0", "--to-cluster", "xyzzy"}, "Can not resolve cluster"},
+		{"unknown configuration", commands.CloneClusterConfiguration, RestAPIMock{}, "tester", []string{"42", "--to-cluster", "1"}, "configuration 42 not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			captured, err := capture.StandardOutput(func() {
+				err := tc.clone(tc.api, tc.username, tc.args, false)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
@@ -23,9 +23,12 @@ package commands
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/clusters.html
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 )
 
 const clusterMessage = "Cluster "
@@ -49,11 +52,25 @@ func ListOfClusters(api restapi.API) {
 	// TODO: handle empty list of clusters
 
 	// list of clusters operation has been successful, let's display them
-	fmt.Println(colorizer.Magenta("List of clusters"))
-	fmt.Printf("%4s %4s %-s\n", "#", "ID", "Name")
+	clustersTable(clusters).print()
+}
+
+// clustersTable function prepares table with list of clusters
+func clustersTable(clusters []types.Cluster) table {
+	result := table{
+		Kind:    "cluster",
+		Title:   "List of clusters",
+		Header:  fmt.Sprintf("%4s %4s %-s", "#", "ID", "Name"),
+		Columns: []string{"Name"},
+	}
 	for i, cluster := range clusters {
-		fmt.Printf("%4d %4d %-s\n", i, cluster.ID, cluster.Name)
+		result.Rows = append(result.Rows, tableRow{
+			ID:     cluster.ID,
+			Fields: []string{cluster.Name},
+			Line:   fmt.Sprintf("%4d %4d %-s", i, cluster.ID, cluster.Name),
+		})
 	}
+	return result
 }
 
 // DeleteClusterNoConfirm function deletes all info about selected cluster w/o
@@ -64,9 +81,17 @@ func DeleteClusterNoConfirm(api restapi.API, clusterID string) {
 }
 
 // DeleteCluster function deletes all info about selected cluster from
-// database. Before this operation is performed, user is ask if it is really
-// required (this additional operation can be disabled by command line option).
+// database. Cluster can be specified by its name, numeric ID, or unambiguous
+// prefix of its name. Before this operation is performed, user is ask if it is
+// really required (this additional operation can be disabled by command line
+// option).
 func DeleteCluster(api restapi.API, clusterID string, askForConfirmation bool) {
+	// find the cluster first, REST API expects its numeric ID
+	cluster, ok := resolveClusterOrReport(api, clusterID)
+	if !ok {
+		return
+	}
+
 	if askForConfirmation {
 		// the client has been configured to ask for additional confirmation
 		// display the confirmation dialog
@@ -77,7 +102,7 @@ func DeleteCluster(api restapi.API, clusterID string, askForConfirmation bool) {
 	}
 
 	// try to delete cluster and display error if something wrong happens
-	err := api.DeleteCluster(clusterID)
+	err := api.DeleteCluster(strconv.Itoa(cluster.ID))
 
 	// check for any error
 	if err != nil {
@@ -88,7 +113,7 @@ func DeleteCluster(api restapi.API, clusterID string, askForConfirmation bool) {
 	}
 
 	// everything's ok, cluster has been deleted
-	fmt.Println(colorizer.Blue(clusterMessage+clusterID+" has been"), colorizer.Red(deleted))
+	fmt.Println(colorizer.Blue(clusterMessage+cluster.Name+hasBeenMessage), colorizer.Red(deleted))
 }
 
 // AddCluster function inserts new cluster info into the database via REST API
@@ -108,3 +133,167 @@ func AddCluster(api restapi.API, clusterName string) {
 	// everything's ok, cluster has been added
 	fmt.Println(colorizer.Blue(clusterMessage + clusterName + " has been added"))
 }
+
+// clusterConfigurationDescription represents one configuration of described
+// cluster together with description of profile it is based on
+type clusterConfigurationDescription struct {
+	types.ClusterConfiguration `yaml:",inline"`
+	ProfileDescription         string `json:"profile_description" yaml:"profile_description"`
+}
+
+// clusterDescription represents all information about one cluster as
+// displayed by 'describe cluster' command
+type clusterDescription struct {
+	Cluster         types.Cluster                     `json:"cluster" yaml:"cluster"`
+	Configurations  []clusterConfigurationDescription `json:"configurations" yaml:"configurations"`
+	PendingTriggers []types.Trigger                   `json:"pending_triggers" yaml:"pending_triggers"`
+	AckedTriggers   []types.Trigger                   `json:"acked_triggers" yaml:"acked_triggers"`
+}
+
+// readClusterDescription function gathers all information about given
+// cluster via REST API calls
+func readClusterDescription(api restapi.API, cluster types.Cluster) (*clusterDescription, error) {
+	description := clusterDescription{
+		Cluster:         cluster,
+		Configurations:  []clusterConfigurationDescription{},
+		PendingTriggers: []types.Trigger{},
+		AckedTriggers:   []types.Trigger{},
+	}
+
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrorReadingListOfConfigurations, err)
+	}
+
+	profiles, err := api.ReadListOfConfigurationProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrorReadingListOfConfigurationProfiles, err)
+	}
+
+	triggers, err := api.ReadListOfTriggers()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrorReadingListOfTriggers, err)
+	}
+
+	// configuration refers to profile by its ID
+	profileDescriptions := map[string]string{}
+	for _, profile := range profiles {
+		profileDescriptions[strconv.Itoa(profile.ID)] = profile.Description
+	}
+
+	for _, configuration := range configurations {
+		if configuration.Cluster == cluster.Name {
+			description.Configurations = append(description.Configurations,
+				clusterConfigurationDescription{
+					ClusterConfiguration: configuration,
+					ProfileDescription:   profileDescriptions[configuration.Configuration],
+				})
+		}
+	}
+
+	for _, trigger := range triggers {
+		if trigger.Cluster != cluster.Name {
+			continue
+		}
+		if triggerAcked(trigger) {
+			description.AckedTriggers = append(description.AckedTriggers, trigger)
+		} else {
+			description.PendingTriggers = append(description.PendingTriggers, trigger)
+		}
+	}
+
+	return &description, nil
+}
+
+// printClusterTriggers function displays list of triggers for described
+// cluster
+func printClusterTriggers(title string, triggers []types.Trigger) {
+	fmt.Println(colorizer.Magenta(title))
+	if len(triggers) == 0 {
+		fmt.Println("none")
+		return
+	}
+	fmt.Printf("%4s %-20s %-20s %-12s %-8s %s\n", "ID", "Type", "Triggered at", "Triggered by", activeTrigger, "Reason")
+	for _, trigger := range triggers {
+		active := colorizer.Red("no")
+		if trigger.Active == 1 {
+			active = colorizer.Green(conditionSet)
+		}
+		fmt.Printf("%4d %-20s %-20s %-12s %-8s %s\n", trigger.ID, trigger.Type,
+			displayedTimestamp(trigger.TriggeredAt), trigger.TriggeredBy, active, trigger.Reason)
+	}
+}
+
+// printClusterDescription function displays all information about cluster in
+// human readable form
+func printClusterDescription(description *clusterDescription) {
+	fmt.Println(colorizer.Magenta("Cluster info"))
+	fmt.Printf("ID:    %d\n", description.Cluster.ID)
+	fmt.Printf("Name:  %s\n", description.Cluster.Name)
+	fmt.Println()
+
+	fmt.Println(colorizer.Magenta("Configurations"))
+	if len(description.Configurations) == 0 {
+		fmt.Println("none")
+	} else {
+		fmt.Printf("  %4s %7s %-20s %-12s %-8s %-20s %s\n", "ID", "Profile", changedAt, changedBy, activeTrigger, "Reason", "Profile description")
+		for _, configuration := range description.Configurations {
+			line := fmt.Sprintf("%4d %7s %-20s %-12s %-8s %-20s %s", configuration.ID,
+				configuration.Configuration, displayedTimestamp(configuration.ChangedAt), configuration.ChangedBy,
+				configurationActiveFlag(configuration.ClusterConfiguration), configuration.Reason,
+				configuration.ProfileDescription)
+			// active configuration is highlighted
+			if configuration.Active == "1" {
+				fmt.Println(colorizer.Green("*"), colorizer.Bold(line))
+			} else {
+				fmt.Println(" ", line)
+			}
+		}
+	}
+	fmt.Println()
+
+	printClusterTriggers("Pending triggers", description.PendingTriggers)
+	fmt.Println()
+	printClusterTriggers("Acked triggers", description.AckedTriggers)
+}
+
+// DescribeCluster function displays cluster info together with all its
+// configurations and triggers. Cluster can be specified by its name, numeric
+// ID, or unambiguous prefix of its name.
+func DescribeCluster(api restapi.API, args []string) error {
+	flags := newFlagSet("describe cluster")
+	output := addOutputFlag(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		err = fmt.Errorf("exactly one cluster needs to be specified")
+	} else {
+		err = checkOutputFormat(*output)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	cluster, ok := resolveClusterOrReport(api, positional[0])
+	if !ok {
+		return errors.New(CannotResolveClusterErrorMessage)
+	}
+
+	description, err := readClusterDescription(api, *cluster)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	if *output != textOutput {
+		return printMachineReadable(*output, description)
+	}
+	printClusterDescription(description)
+	return nil
+}
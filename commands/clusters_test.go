@@ -20,7 +20,9 @@ package commands_test
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/clusters_test.html
 
 import (
+	"encoding/json"
 	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 	"github.com/tisnik/go-capture"
 	"strings"
 	"testing"
@@ -261,3 +263,212 @@ func TestDeleteClusterNoConfirmError(t *testing.T) {
 		t.Fatal("Unexpected output:\n", captured)
 	}
 }
+
+// TestDescribeCluster checks the command 'describe cluster'
+func TestDescribeCluster(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	restAPIMock := RestAPIMock{}
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(restAPIMock, []string{"1"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	// check if all parts of description are displayed
+	if !strings.HasPrefix(captured, "Cluster info") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	expectedParts := []string{
+		"00000000-0000-0000-0000-000000000000",
+		"Configurations",
+		"default configuration profile",
+		"another configuration profile",
+		"Pending triggers",
+		"Acked triggers",
+		"different-trigger",
+	}
+	for _, expectedPart := range expectedParts {
+		if !strings.Contains(captured, expectedPart) {
+			t.Fatal("Can not find", expectedPart, "in output:\n", captured)
+		}
+	}
+}
+
+// TestDescribeClusterJSON checks the command 'describe cluster' with JSON
+// output
+func TestDescribeClusterJSON(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	restAPIMock := RestAPIMock{}
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(restAPIMock, []string{"0000", "--output", "json"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	var description struct {
+		Cluster         types.Cluster `json:"cluster"`
+		Configurations  []interface{} `json:"configurations"`
+		PendingTriggers []interface{} `json:"pending_triggers"`
+		AckedTriggers   []interface{} `json:"acked_triggers"`
+	}
+	err = json.Unmarshal([]byte(captured), &description)
+	if err != nil {
+		t.Fatal("Output is not valid JSON:", err, captured)
+	}
+
+	// three configurations and two triggers are mocked for the cluster
+	if description.Cluster.ID != 1 || len(description.Configurations) != 3 ||
+		len(description.PendingTriggers) != 1 || len(description.AckedTriggers) != 1 {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeClusterYAML checks the command 'describe cluster' with YAML
+// output
+func TestDescribeClusterYAML(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	restAPIMock := RestAPIMock{}
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(restAPIMock, []string{"--output", "yaml", "1"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "cluster:") ||
+		!strings.Contains(captured, "profile_description: default configuration profile") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeClusterImproperOutput checks that unsupported output format is
+// refused
+func TestDescribeClusterImproperOutput(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	restAPIMock := RestAPIMock{}
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(restAPIMock, []string{"1", "--output", "xml"})
+		if err == nil {
+			t.Error("Error is expected to be returned")
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Invalid command arguments") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeClusterError checks the command 'describe cluster' when error
+// is reported by REST API
+func TestDescribeClusterError(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	restAPIMock := RestAPIMockErrors{}
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(restAPIMock, []string{"1"})
+		if err == nil {
+			t.Error("Error is expected to be returned")
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Error communicating with the service") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// shortTimestampsMock is an implementation of mocked REST API that returns
+// profiles, configurations and triggers with truncated timestamps
+type shortTimestampsMock struct {
+	RestAPIMock
+}
+
+// ReadListOfConfigurations returns configurations with truncated timestamps
+func (api shortTimestampsMock) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	configurations, err := api.RestAPIMock.ReadListOfConfigurations()
+	for i := range configurations {
+		configurations[i].ChangedAt = "2020-01-01"
+	}
+	return configurations, err
+}
+
+// ReadListOfTriggers returns triggers with truncated timestamps
+func (api shortTimestampsMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	triggers, err := api.RestAPIMock.ReadListOfTriggers()
+	for i := range triggers {
+		triggers[i].TriggeredAt = ""
+		triggers[i].AckedAt = "1970"
+	}
+	return triggers, err
+}
+
+// ReadTriggerByID returns trigger with truncated timestamps
+func (api shortTimestampsMock) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	trigger, err := api.RestAPIMock.ReadTriggerByID(triggerID)
+	if trigger != nil {
+		trigger.TriggeredAt = ""
+		trigger.AckedAt = "1970"
+	}
+	return trigger, err
+}
+
+// ReadListOfConfigurationProfiles returns profiles with truncated timestamps
+func (api shortTimestampsMock) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	profiles, err := api.RestAPIMock.ReadListOfConfigurationProfiles()
+	for i := range profiles {
+		profiles[i].ChangedAt = "2020-01-01"
+	}
+	return profiles, err
+}
+
+// TestDescribeClusterShortTimestamps checks that the command 'describe
+// cluster' displays timestamps that can't be parsed as they are
+func TestDescribeClusterShortTimestamps(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.DescribeCluster(shortTimestampsMock{}, []string{"1"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	// check if capture operation was finished correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "2020-01-01 ") || !strings.Contains(captured, "Pending triggers") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -21,8 +21,22 @@ limitations under the License.
 // Types, functions, and methods from this package are implemented in following
 // source files:
 //
+// * activation.go
+//
+// * apply.go
+//
+// * arguments.go
+//
+// * audit.go
+//
 // * authors.go
 //
+// * backup.go
+//
+// * bulk.go
+//
+// * clone.go
+//
 // * clusters.go
 //
 // * commands.go
@@ -33,17 +47,53 @@ limitations under the License.
 //
 // * copyright.go
 //
+// * diff.go
+//
+// * drift.go
+//
+// * editor.go
+//
 // * errors.go
 //
+// * fleet.go
+//
 // * help.go
 //
+// * history.go
+//
+// * hyperlinks.go
+//
+// * jsondiff.go
+//
 // * license.go
 //
 // * messages.go
 //
+// * notify.go
+//
+// * output.go
+//
 // * profiles.go
 //
+// * prune.go
+//
+// * report.go
+//
+// * resolver.go
+//
+// * rollback.go
+//
+// * schedule.go
+//
+// * serve.go
+//
+// * table.go
+//
 // * triggers.go
+//
+// * wait.go
+//
+// * watch.go
 package commands
 
 // Generated documentation is available at:
@@ -26,14 +26,23 @@ import (
 	"fmt"
 	"github.com/c-bata/go-prompt"
 	"github.com/logrusorgru/aurora"
+	"golang.org/x/crypto/ssh/terminal"
 	"os"
 	"path/filepath"
+	"time"
 )
 
+// timestampLayout is layout of timestamps returned by the controller service
+// (just the part that is displayed to user)
+const timestampLayout = "2006-01-02T15:04:05"
+
 // files will be filled by list of files that are found in given directory and
 // displayed to user to select one of them
 var files []prompt.Suggest
 
+// currentTime returns current time, it is replaced in unit tests
+var currentTime = time.Now
+
 // colorizer contains instance of terminal colorizer interface
 var colorizer aurora.Aurora
 
@@ -89,6 +98,39 @@ func FillInConfigurationList(directory string) error {
 	return nil
 }
 
+// parseTimestamp function parses timestamp returned by the controller
+// service. Fractional seconds and time zone, if any, are ignored.
+func parseTimestamp(timestamp string) (time.Time, error) {
+	if len(timestamp) < len(timestampLayout) {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %q", timestamp)
+	}
+	return time.Parse(timestampLayout, timestamp[:len(timestampLayout)])
+}
+
+// displayedTimestamp function returns timestamp returned by the controller
+// service without fractional seconds and time zone. Timestamps that can't be
+// parsed are displayed as they are.
+func displayedTimestamp(timestamp string) string {
+	parsed, err := parseTimestamp(timestamp)
+	if err != nil {
+		return timestamp
+	}
+	return parsed.Format(timestampLayout)
+}
+
+// stdinIsTerminal function checks whether standard input is connected to
+// terminal, i.e. whether user can answer questions
+func stdinIsTerminal() bool {
+	return terminal.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// stdoutIsTerminal function checks whether standard output is connected to
+// terminal
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
 // Quit function will exit from the CLI client.
 func Quit() {
 	fmt.Println(colorizer.Magenta("Quitting"))
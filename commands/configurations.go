@@ -23,11 +23,14 @@ package commands
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/configurations.html
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 	"github.com/c-bata/go-prompt"
 	"github.com/logrusorgru/aurora"
 )
@@ -54,21 +57,41 @@ func ListOfConfigurations(api restapi.API, filter string) {
 	}
 
 	// list all configurations returned in HTTP response
-	fmt.Println(colorizer.Magenta("List of configurations for all clusters"))
-	fmt.Printf("%4s %4s %4s    %-20s %-20s %-10s %-12s %s\n", "#", "ID", "Profile", clusterUUID, changedAt, changedBy, activeTrigger, "Reason")
+	configurationsTable(configurations, filter).print()
+}
+
+// configurationsTable function prepares table with list of configurations
+// for clusters that match the filter
+func configurationsTable(configurations []types.ClusterConfiguration, filter string) table {
+	result := table{
+		Kind:    "configuration",
+		Title:   "List of configurations for all clusters",
+		Header:  fmt.Sprintf("%4s %4s %4s    %-20s %-20s %-10s %-12s %s", "#", "ID", "Profile", clusterUUID, changedAt, changedBy, activeTrigger, "Reason"),
+		Columns: []string{"Profile", clusterUUID, changedAt, changedBy, activeTrigger, "Reason"},
+	}
 	for i, configuration := range configurations {
 		// perform poor man's filtering on client side
 		if strings.Contains(configuration.Cluster, filter) {
-			var active aurora.Value
-			if configuration.Active == "1" {
-				active = colorizer.Green(conditionSet)
-			} else {
-				active = colorizer.Red("no")
-			}
-			changedAt := configuration.ChangedAt[0:19]
-			fmt.Printf("%4d %4d %4s       %-20s %-20s %-10s %-12s %s\n", i, configuration.ID, configuration.Configuration, configuration.Cluster, changedAt, configuration.ChangedBy, active, configuration.Reason)
+			plainActive, active := activeFlag(configuration.Active == "1")
+			changedAt := displayedTimestamp(configuration.ChangedAt)
+			result.Rows = append(result.Rows, tableRow{
+				ID: configuration.ID,
+				Fields: []string{configuration.Configuration, configuration.Cluster, changedAt,
+					configuration.ChangedBy, plainActive, configuration.Reason},
+				Line: fmt.Sprintf("%4d %4d %4s       %-20s %-20s %-10s %-12s %s", i, configuration.ID, configuration.Configuration, configuration.Cluster, changedAt, configuration.ChangedBy, active, configuration.Reason),
+			})
 		}
 	}
+	return result
+}
+
+// configurationActiveFlag function returns colorized flag whether the
+// configuration is active
+func configurationActiveFlag(configuration types.ClusterConfiguration) aurora.Value {
+	if configuration.Active == "1" {
+		return colorizer.Green(conditionSet)
+	}
+	return colorizer.Red("no")
 }
 
 // EnableClusterConfiguration function enables the selected cluster
@@ -144,12 +167,19 @@ func AddClusterConfiguration(api restapi.API, username string) {
 	}
 
 	// ask user about cluster ID
-	cluster := prompt.Input("cluster: ", LoginCompleter)
-	if cluster == "" {
+	clusterID := prompt.Input("cluster: ", LoginCompleter)
+	if clusterID == "" {
 		fmt.Println(colorizer.Red(operationCancelled))
 		return
 	}
 
+	// cluster can be specified by its name, ID, or prefix of its name
+	resolved, ok := resolveClusterOrReport(api, clusterID)
+	if !ok {
+		return
+	}
+	cluster := resolved.Name
+
 	// ask user about reason
 	reason := prompt.Input(reasonPrompt, LoginCompleter)
 	if reason == "" {
@@ -208,3 +238,231 @@ func AddClusterConfigurationImpl(api restapi.API, username, cluster, reason, des
 	// everything's ok, configuration has been created
 	fmt.Println(colorizer.Blue("Configuration has been created"))
 }
+
+// configurationSelector function returns function that reads list of
+// configurations and selects IDs of configurations for given cluster that
+// match the applicable predicate
+func configurationSelector(api restapi.API, cluster string, applicable func(types.ClusterConfiguration) bool) func() ([]string, error) {
+	return func() ([]string, error) {
+		cluster, err := resolveClusterName(api, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		configurations, err := api.ReadListOfConfigurations()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrorReadingListOfConfigurations, err)
+		}
+
+		ids := []string{}
+		for _, configuration := range configurations {
+			if configuration.Cluster == cluster && applicable(configuration) {
+				ids = append(ids, strconv.Itoa(configuration.ID))
+			}
+		}
+		return ids, nil
+	}
+}
+
+// bulkConfigurationCommand function parses arguments of bulk configuration
+// command and runs it for all selected configurations
+func bulkConfigurationCommand(api restapi.API, name string, args []string, command bulkCommand,
+	applicable func(types.ClusterConfiguration) bool, askForConfirmation bool) error {
+	flags := newFlagSet(name)
+	cluster := flags.String(clusterFlag, "", "select all configurations for given cluster")
+	options := addBulkFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+
+	return runBulkCommand(command, positional, *cluster != "",
+		configurationSelector(api, *cluster, applicable), *options, askForConfirmation)
+}
+
+// DeleteClusterConfigurations function deletes all configurations specified
+// by list of IDs (for example "3,5,9-20") or selected by --cluster flag.
+func DeleteClusterConfigurations(api restapi.API, args []string, askForConfirmation bool) error {
+	command := bulkCommand{
+		subject:   configurationChangeMsg,
+		done:      colorizer.Red(deleted),
+		question:  "%d configurations will be deleted: %s",
+		operation: api.DeleteClusterConfiguration,
+	}
+	all := func(types.ClusterConfiguration) bool { return true }
+	return bulkConfigurationCommand(api, "delete configuration", args, command, all, askForConfirmation)
+}
+
+// EnableClusterConfigurations function enables all configurations specified
+// by list of IDs or selected by --cluster flag.
+func EnableClusterConfigurations(api restapi.API, args []string) error {
+	command := bulkCommand{
+		subject:   configurationChangeMsg,
+		done:      colorizer.Green("enabled"),
+		operation: api.EnableClusterConfiguration,
+	}
+	disabled := func(configuration types.ClusterConfiguration) bool { return configuration.Active != "1" }
+	return bulkConfigurationCommand(api, "enable configuration", args, command, disabled, false)
+}
+
+// DisableClusterConfigurations function disables all configurations specified
+// by list of IDs or selected by --cluster flag.
+func DisableClusterConfigurations(api restapi.API, args []string) error {
+	command := bulkCommand{
+		subject:   configurationChangeMsg,
+		done:      colorizer.Red("disabled"),
+		operation: api.DisableClusterConfiguration,
+	}
+	enabled := func(configuration types.ClusterConfiguration) bool { return configuration.Active == "1" }
+	return bulkConfigurationCommand(api, "disable configuration", args, command, enabled, false)
+}
+
+// findConfiguration function tries to find cluster configuration by its ID
+// in the list of configurations.
+func findConfiguration(configurations []types.ClusterConfiguration, configurationID string) (*types.ClusterConfiguration, error) {
+	for i := range configurations {
+		if strconv.Itoa(configurations[i].ID) == configurationID {
+			return &configurations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("configuration %s not found", configurationID)
+}
+
+// latestConfigurationID function returns the highest ID of configuration for
+// given cluster or -1 when the cluster has no configuration
+func latestConfigurationID(configurations []types.ClusterConfiguration, cluster string) int {
+	latest := -1
+	for _, configuration := range configurations {
+		if configuration.Cluster == cluster && configuration.ID > latest {
+			latest = configuration.ID
+		}
+	}
+	return latest
+}
+
+// activateLatestConfiguration function makes the configuration created
+// after the configuration with previousID the only active configuration of
+// given cluster. ID of activated configuration is returned.
+func activateLatestConfiguration(api restapi.API, cluster string, previousID int) (string, error) {
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", ErrorReadingListOfConfigurations, err)
+	}
+
+	siblings := clusterHistory(configurations, cluster)
+	latest := -1
+	for i, configuration := range siblings {
+		if configuration.ID > previousID && (latest < 0 || configuration.ID > siblings[latest].ID) {
+			latest = i
+		}
+	}
+	if latest < 0 {
+		return "", errors.New("the new configuration can not be found")
+	}
+
+	latestID := strconv.Itoa(siblings[latest].ID)
+	_, err = activateExclusively(api, siblings, latest)
+	return latestID, err
+}
+
+// EditClusterConfiguration function opens the cluster configuration selected
+// by its ID in text editor and stores the edited version as a new active
+// configuration for the same cluster. Description of the original
+// configuration is used for the new one.
+func EditClusterConfiguration(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("edit configuration")
+	reason := flags.String("reason", "", "reason for the configuration change")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		err = fmt.Errorf("exactly one configuration needs to be specified")
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	configurationID := positional[0]
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	// cluster and profile are not part of configuration payload
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+	original, err := findConfiguration(configurations, configurationID)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingClusterConfiguration))
+		fmt.Println(err)
+		return err
+	}
+	previousID := latestConfigurationID(configurations, original.Cluster)
+
+	profile, err := api.ReadConfigurationProfile(original.Configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingConfigurationProfile))
+		fmt.Println(err)
+		return err
+	}
+
+	payload, err := api.ReadClusterConfigurationByID(configurationID)
+	if err == nil && payload == nil {
+		err = fmt.Errorf("configuration %s is empty", configurationID)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingClusterConfiguration))
+		fmt.Println(err)
+		return err
+	}
+
+	configuration, err := editJSONDocument([]byte(*payload), "configuration-*.json")
+	if err != nil || configuration == nil {
+		return err
+	}
+
+	// ask user about reason if it is not specified on command line
+	if *reason == "" {
+		*reason = prompt.Input(reasonPrompt, LoginCompleter)
+		if *reason == "" {
+			fmt.Println(colorizer.Red(operationCancelled))
+			return nil
+		}
+	}
+
+	if askForConfirmation {
+		if !ProceedQuestion("New configuration will be created and activated for cluster " + original.Cluster) {
+			return nil
+		}
+	}
+
+	// try to add cluster configuration and display error if something
+	// wrong happens
+	err = api.AddClusterConfiguration(username, original.Cluster, *reason, profile.Description, configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	fmt.Println(colorizer.Blue("Configuration has been created"))
+
+	// the new configuration has to be the only active one
+	activated, err := activateLatestConfiguration(api, original.Cluster, previousID)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotActivateConfigurationErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, configuration has been created and activated
+	fmt.Println(colorizer.Blue(configurationChangeMsg+activated+hasBeenMessage), colorizer.Green("activated"))
+	return nil
+}
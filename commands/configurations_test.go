@@ -20,7 +20,10 @@ package commands_test
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/configurations_test.html
 
 import (
+	"errors"
 	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 	"github.com/tisnik/go-capture"
 	"os"
 	"strings"
@@ -401,3 +404,207 @@ func TestAddClusterConfigurationImplBadConfiguration(t *testing.T) {
 		t.Fatal("Configuration should not be created when configuration file does not exist")
 	}
 }
+
+// configurationStoreMock is mocked REST API that remembers configurations
+// created by AddClusterConfiguration and records all calls that change the
+// configurations
+type configurationStoreMock struct {
+	RestAPIMock
+	configurations *[]types.ClusterConfiguration
+	calls          *[]string
+
+	// call that should fail, for example "disable 1"
+	failOn string
+}
+
+// newConfigurationStoreMock function constructs mocked REST API with the
+// same configurations as RestAPIMock has
+func newConfigurationStoreMock() configurationStoreMock {
+	configurations, _ := RestAPIMock{}.ReadListOfConfigurations()
+	return configurationStoreMock{
+		configurations: &configurations,
+		calls:          &[]string{},
+	}
+}
+
+// ReadListOfConfigurations returns all remembered configurations
+func (api configurationStoreMock) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	return *api.configurations, nil
+}
+
+// AddClusterConfiguration remembers new inactive configuration
+func (api configurationStoreMock) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	*api.calls = append(*api.calls, "add "+cluster+" "+reason+" "+description+" "+string(configuration))
+	*api.configurations = append(*api.configurations, types.ClusterConfiguration{
+		ID:            len(*api.configurations),
+		Cluster:       cluster,
+		Configuration: "0",
+		ChangedAt:     "2020-01-02T00:00:00",
+		ChangedBy:     username,
+		Active:        "0",
+		Reason:        reason,
+	})
+	return nil
+}
+
+// AddConfigurationProfile records the call
+func (api configurationStoreMock) AddConfigurationProfile(username, description string, configuration []byte) error {
+	return api.record("add profile " + description + " " + string(configuration))
+}
+
+// record method records the call and returns error for call that should
+// fail
+func (api configurationStoreMock) record(call string) error {
+	*api.calls = append(*api.calls, call)
+	if call == api.failOn {
+		return errors.New(call + " error")
+	}
+	return nil
+}
+
+// EnableClusterConfiguration records the call
+func (api configurationStoreMock) EnableClusterConfiguration(configurationID string) error {
+	return api.record("enable " + configurationID)
+}
+
+// DisableClusterConfiguration records the call
+func (api configurationStoreMock) DisableClusterConfiguration(configurationID string) error {
+	return api.record("disable " + configurationID)
+}
+
+// TestEditClusterConfiguration function checks whether the edited
+// configuration is stored as a new configuration that is activated.
+func TestEditClusterConfiguration(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor replaces the whole configuration
+	setEditor(t, `printf "{\"watch\": []}" > "$1"`)
+
+	api := newConfigurationStoreMock()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditClusterConfiguration(api, "tester", []string{"0", "--reason", "fix watch"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	expected := []string{
+		"- configuration#0",
+		`+ {"watch": []}`,
+		"Configuration has been created",
+		"Configuration 3 has been activated",
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+
+	// cluster and description needs to be carried over, the new
+	// configuration needs to be the only active one
+	expectedCalls := []string{
+		`add 00000000-0000-0000-0000-000000000000 fix watch empty configuration {"watch": []}`,
+		"enable 3",
+		"disable 0",
+		"disable 1",
+	}
+	if strings.Join(*api.calls, "\n") != strings.Join(expectedCalls, "\n") {
+		t.Fatal("Unexpected calls:\n", strings.Join(*api.calls, "\n"))
+	}
+}
+
+// TestEditClusterConfigurationNotActivated function checks the behaviour when
+// the new configuration can not be found after it has been created.
+func TestEditClusterConfigurationNotActivated(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor replaces the whole configuration
+	setEditor(t, `printf "{}" > "$1"`)
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditClusterConfiguration(RestAPIMock{}, "tester", []string{"0", "--reason", "x"}, false)
+		if err == nil {
+			t.Fatal("Error is expected")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	if !strings.Contains(captured, "Can not activate configuration") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestEditClusterConfigurationErrors function checks the error handling in
+// the edit configuration command.
+func TestEditClusterConfigurationErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor replaces the whole configuration
+	setEditor(t, `printf "{}" > "$1"`)
+
+	testCases := []struct {
+		name     string
+		api      restapi.API
+		username string
+		args     []string
+		expected string
+	}{
+		{"no configuration", RestAPIMock{}, "tester", []string{}, "Invalid command arguments"},
+		{"not logged in", RestAPIMock{}, "", []string{"0"}, "Not logged in"},
+		{"unknown configuration", RestAPIMock{}, "tester", []string{"42"}, "configuration 42 not found"},
+		{"empty configuration", RestAPIMock{}, "tester", []string{"1"}, "configuration 1 is empty"},
+		{"read error", RestAPIMockErrors{}, "tester", []string{"0"}, "Error reading list of configurations"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// use go-capture package to capture all writes to standard output
+			captured, err := capture.StandardOutput(func() {
+				err := commands.EditClusterConfiguration(tc.api, tc.username, tc.args, false)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			// test the captured output
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
+
+// TestListOfConfigurationsShortTimestamps checks that the command 'list configurations'
+// displays timestamps that can't be parsed as they are
+func TestListOfConfigurationsShortTimestamps(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		commands.ListOfConfigurations(shortTimestampsMock{}, "")
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "2020-01-01") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
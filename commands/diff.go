@@ -0,0 +1,357 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/diff.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// kinds of lines in line-based diff
+const (
+	unchangedLine = ' '
+	removedLine   = '-'
+	addedLine     = '+'
+)
+
+// diffContext is number of unchanged lines displayed around each change
+const diffContext = 3
+
+// supported output formats of diff command
+const (
+	structuralDiffFormat = "structural"
+	unifiedDiffFormat    = "unified"
+	patchDiffFormat      = "patch"
+)
+
+// prefixes used to specify diff operands stored in controller service, all
+// other operands are considered to be file names
+const (
+	profileOperandPrefix       = "profile:"
+	configurationOperandPrefix = "configuration:"
+)
+
+// diffLine represents one line in line-based diff
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// diffHunk represents continuous part of line-based diff with changed lines
+// and their context
+type diffHunk struct {
+	oldStart int
+	oldCount int
+	newStart int
+	newCount int
+	lines    []diffLine
+}
+
+// splitLines function splits text into lines, the trailing newline is ignored
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
+
+// lineDiff function computes line-based diff between two texts. Longest
+// common subsequence is used to find unchanged lines, which is fast enough
+// for configurations with hundreds of lines.
+func lineDiff(oldText, newText string) []diffLine {
+	a := splitLines(oldText)
+	b := splitLines(newText)
+
+	// lcs[i][j] is length of longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := []diffLine{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{unchangedLine, a[i]})
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, diffLine{addedLine, b[j]})
+			j++
+		default:
+			lines = append(lines, diffLine{removedLine, a[i]})
+			i++
+		}
+	}
+	return lines
+}
+
+// diffChanged function returns true if the diff contains any change
+func diffChanged(lines []diffLine) bool {
+	for _, line := range lines {
+		if line.kind != unchangedLine {
+			return true
+		}
+	}
+	return false
+}
+
+// visibleDiffLines function marks lines that are close enough to any change
+// to be displayed
+func visibleDiffLines(lines []diffLine) []bool {
+	visible := make([]bool, len(lines))
+	for i, line := range lines {
+		if line.kind == unchangedLine {
+			continue
+		}
+		for j := i - diffContext; j <= i+diffContext; j++ {
+			if j >= 0 && j < len(lines) {
+				visible[j] = true
+			}
+		}
+	}
+	return visible
+}
+
+// diffHunks function splits line-based diff into hunks, line numbers start
+// from 1 as in unified diff format
+func diffHunks(lines []diffLine) []diffHunk {
+	visible := visibleDiffLines(lines)
+	hunks := []diffHunk{}
+
+	var current *diffHunk
+	oldLine, newLine := 1, 1
+	for i, line := range lines {
+		if !visible[i] {
+			current = nil
+		} else {
+			if current == nil {
+				hunks = append(hunks, diffHunk{oldStart: oldLine, newStart: newLine})
+				current = &hunks[len(hunks)-1]
+			}
+			current.lines = append(current.lines, line)
+			if line.kind != addedLine {
+				current.oldCount++
+			}
+			if line.kind != removedLine {
+				current.newCount++
+			}
+		}
+		if line.kind != addedLine {
+			oldLine++
+		}
+		if line.kind != removedLine {
+			newLine++
+		}
+	}
+	return hunks
+}
+
+// printDiffLine function displays one line of diff, removed line is
+// displayed in red, added line in green
+func printDiffLine(line diffLine, separator string) {
+	text := string(line.kind) + separator + line.text
+	switch line.kind {
+	case removedLine:
+		fmt.Println(colorizer.Red(text))
+	case addedLine:
+		fmt.Println(colorizer.Green(text))
+	default:
+		fmt.Println(text)
+	}
+}
+
+// printLineDiff function displays changed lines with few lines of context
+// around them. Nothing is displayed when there are no changes.
+func printLineDiff(lines []diffLine) {
+	if !diffChanged(lines) {
+		return
+	}
+
+	visible := visibleDiffLines(lines)
+	skipped := false
+	for i, line := range lines {
+		if !visible[i] {
+			skipped = true
+			continue
+		}
+		if skipped {
+			fmt.Println(colorizer.Cyan("..."))
+			skipped = false
+		}
+		printDiffLine(line, " ")
+	}
+	if skipped {
+		fmt.Println(colorizer.Cyan("..."))
+	}
+}
+
+// hunkRange function formats range of lines used in hunk header
+func hunkRange(start, count int) string {
+	// empty range refers to the line before
+	if count == 0 {
+		start--
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// printUnifiedDiff function displays line-based diff in unified format.
+// Nothing is displayed when there are no changes.
+func printUnifiedDiff(oldName, newName string, lines []diffLine) {
+	if !diffChanged(lines) {
+		return
+	}
+
+	fmt.Println(colorizer.Bold("--- " + oldName))
+	fmt.Println(colorizer.Bold("+++ " + newName))
+	for _, hunk := range diffHunks(lines) {
+		fmt.Println(colorizer.Cyan("@@ -" + hunkRange(hunk.oldStart, hunk.oldCount) +
+			" +" + hunkRange(hunk.newStart, hunk.newCount) + " @@"))
+		for _, line := range hunk.lines {
+			printDiffLine(line, "")
+		}
+	}
+}
+
+// readDiffOperand function reads JSON document to be compared. The operand
+// can be configuration profile (profile:ID), cluster configuration
+// (configuration:ID), or path to local file. Files are also searched in the
+// directory with configurations.
+func readDiffOperand(api restapi.API, operand string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(operand, profileOperandPrefix):
+		profile, err := api.ReadConfigurationProfile(strings.TrimPrefix(operand, profileOperandPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrorReadingConfigurationProfile, err)
+		}
+		return []byte(profile.Configuration), nil
+	case strings.HasPrefix(operand, configurationOperandPrefix):
+		configurationID := strings.TrimPrefix(operand, configurationOperandPrefix)
+		configuration, err := api.ReadClusterConfigurationByID(configurationID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrorReadingClusterConfiguration, err)
+		}
+		if configuration == nil {
+			return nil, fmt.Errorf("configuration %s is empty", configurationID)
+		}
+		return []byte(*configuration), nil
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	content, err := os.ReadFile(operand) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) && !strings.ContainsRune(operand, os.PathSeparator) {
+		content, err = os.ReadFile(pathToConfigFile(configFileDirectory, operand)) // #nosec G304
+	}
+	return content, err
+}
+
+// readNormalizedDiffOperand function reads JSON document to be compared and
+// normalizes it
+func readNormalizedDiffOperand(api restapi.API, operand string) (interface{}, error) {
+	content, err := readDiffOperand(api, operand)
+	if err != nil {
+		return nil, err
+	}
+	document, err := normalizeJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid JSON: %v", operand, err)
+	}
+	return document, nil
+}
+
+// Diff function displays differences between two JSON documents. Each
+// document can be configuration profile (profile:ID), cluster configuration
+// (configuration:ID), or local file. Documents are normalized before they
+// are compared, so formatting and order of keys does not matter.
+func Diff(api restapi.API, args []string) error {
+	flags := newFlagSet("diff")
+	format := flags.String("format", structuralDiffFormat, "output format: structural, unified or patch")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 2:
+		err = fmt.Errorf("exactly two documents need to be specified")
+	case *format != structuralDiffFormat && *format != unifiedDiffFormat && *format != patchDiffFormat:
+		err = fmt.Errorf("unsupported diff format: %s", *format)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	documents := make([]interface{}, len(positional))
+	for i, operand := range positional {
+		documents[i], err = readNormalizedDiffOperand(api, operand)
+		if err != nil {
+			fmt.Println(colorizer.Red(CannotReadDocumentErrorMessage))
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	switch *format {
+	case unifiedDiffFormat:
+		lines := lineDiff(formatNormalizedJSON(documents[0]), formatNormalizedJSON(documents[1]))
+		if !diffChanged(lines) {
+			fmt.Println(colorizer.Blue(noDifferences))
+			return nil
+		}
+		printUnifiedDiff(positional[0], positional[1], lines)
+	case patchDiffFormat:
+		patch, err := json.MarshalIndent(jsonPatch(structuralDiff(documents[0], documents[1])), "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(patch))
+	default:
+		changes := structuralDiff(documents[0], documents[1])
+		if len(changes) == 0 {
+			fmt.Println(colorizer.Blue(noDifferences))
+			return nil
+		}
+		printStructuralDiff(changes, documents[0], documents[1])
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking line-based diff and diff command.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/diff_test.html
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// TestPrintLineDiff function checks whether added, removed and unchanged
+// lines are displayed correctly.
+func TestPrintLineDiff(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		commands.PrintLineDiff("a\nb\nc\n", "a\nx\nc\nd\n")
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	expected := "  a\n- b\n+ x\n  c\n+ d\n"
+	if captured != expected {
+		t.Fatalf("Unexpected diff:\n%s", captured)
+	}
+}
+
+// TestPrintLineDiffContext function checks whether unchanged lines far from
+// any change are not displayed.
+func TestPrintLineDiffContext(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	oldLines := []string{}
+	for i := 0; i < 20; i++ {
+		oldLines = append(oldLines, strings.Repeat("x", i+1))
+	}
+	newLines := append([]string{}, oldLines...)
+	newLines[10] = "changed"
+
+	captured, err := capture.StandardOutput(func() {
+		commands.PrintLineDiff(strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// three lines of context around one change + two separators
+	lines := strings.Split(strings.TrimSuffix(captured, "\n"), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("Unexpected diff:\n%s", captured)
+	}
+	if lines[0] != "..." || lines[9] != "..." {
+		t.Fatalf("Skipped lines are not marked:\n%s", captured)
+	}
+}
+
+// TestPrintLineDiffNoChanges function checks the diff of the same texts.
+func TestPrintLineDiffNoChanges(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		commands.PrintLineDiff("a\nb\n", "a\nb\n")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if captured != "" {
+		t.Fatalf("Unexpected diff:\n%s", captured)
+	}
+}
+
+// writeDocument is a helper function that stores JSON document into
+// temporary file and returns its name
+func writeDocument(t *testing.T, name, content string) string {
+	fileName := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(fileName, []byte(content), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+// documents used by diff tests
+const (
+	oldDocument = `{"no_op": "X", "watch": ["a", "b", "c"], "level": 1, "nested": {"x": 1}}`
+	newDocument = `{
+    "level": 2,
+    "nested": {"x": 1, "y": null},
+    "watch": ["a", "B"]
+}`
+)
+
+// runDiff is a helper function that runs diff command for two documents and
+// returns captured output
+func runDiff(t *testing.T, args ...string) string {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	oldFile := writeDocument(t, "old.json", oldDocument)
+	newFile := writeDocument(t, "new.json", newDocument)
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Diff(RestAPIMock{}, append([]string{oldFile, newFile}, args...))
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured
+}
+
+// TestDiffStructural function checks the structural diff between two files.
+func TestDiffStructural(t *testing.T) {
+	captured := runDiff(t)
+
+	expected := `~ level: 1 -> 2
++ nested.y: null
+- no_op: "X"
+~ watch[1]: "b" -> "B"
+- watch[2]: "c"
+`
+	if captured != expected {
+		t.Fatalf("Unexpected diff:\n%s", captured)
+	}
+}
+
+// TestDiffUnified function checks the unified diff between normalized
+// documents.
+func TestDiffUnified(t *testing.T) {
+	captured := runDiff(t, "--format", "unified")
+
+	expected := []string{
+		"@@ -1,12 +1,11 @@",
+		"-    \"level\": 1,",
+		"+    \"level\": 2,",
+		"-    \"no_op\": \"X\",",
+		"+        \"y\": null",
+		"-        \"c\"",
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e+"\n") {
+			t.Fatalf("Line %s not found in diff:\n%s", e, captured)
+		}
+	}
+}
+
+// TestDiffPatch function checks the JSON patch between two documents.
+func TestDiffPatch(t *testing.T) {
+	captured := runDiff(t, "--format", "patch")
+
+	var patch []map[string]interface{}
+	err := json.Unmarshal([]byte(captured), &patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`{"op":"replace","path":"/level","value":2}`,
+		`{"op":"add","path":"/nested/y","value":null}`,
+		`{"op":"remove","path":"/no_op"}`,
+		`{"op":"replace","path":"/watch/1","value":"B"}`,
+		`{"op":"remove","path":"/watch/2"}`,
+	}
+	if len(patch) != len(expected) {
+		t.Fatalf("Unexpected patch:\n%s", captured)
+	}
+	for i, operation := range patch {
+		serialized, _ := json.Marshal(operation)
+		if string(serialized) != expected[i] {
+			t.Fatalf("Unexpected operation %s, expected %s", serialized, expected[i])
+		}
+	}
+}
+
+// TestDiffNoDifferences function checks that differently formatted documents
+// with the same content are considered to be equal.
+func TestDiffNoDifferences(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	first := writeDocument(t, "first.json", `{"a": 1, "b": [1, 2]}`)
+	second := writeDocument(t, "second.json", "{\n  \"b\": [1, 2],\n  \"a\": 1\n}\n")
+
+	for _, format := range []string{"structural", "unified"} {
+		captured, err := capture.StandardOutput(func() {
+			err := commands.Diff(RestAPIMock{}, []string{first, second, "--format", format})
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		// check if capture was done correctly
+		checkCapturedOutput(t, captured, err)
+
+		if captured != "No differences found\n" {
+			t.Fatalf("Unexpected output for format %s:\n%s", format, captured)
+		}
+	}
+}
+
+// TestDiffErrors function checks the error handling in diff command.
+func TestDiffErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	valid := writeDocument(t, "valid.json", `{}`)
+	invalid := writeDocument(t, "invalid.json", `{`)
+
+	testCases := []struct {
+		name     string
+		api      restapi.API
+		args     []string
+		expected string
+	}{
+		{"no documents", RestAPIMock{}, []string{}, "exactly two documents need to be specified"},
+		{"improper format", RestAPIMock{}, []string{valid, valid, "--format", "foo"}, "unsupported diff format: foo"},
+		{"missing file", RestAPIMock{}, []string{valid, "this-file-does-not-exist.json"}, "Can not read document to be compared"},
+		{"invalid JSON", RestAPIMock{}, []string{valid, invalid}, "is not valid JSON"},
+		{"invalid profile", RestAPIMock{}, []string{valid, "profile:0"}, "profile:0 is not valid JSON"},
+		{"empty configuration", RestAPIMock{}, []string{valid, "configuration:1"}, "configuration 1 is empty"},
+		{"profile read error", RestAPIMockErrors{}, []string{valid, "profile:0"}, "Error reading configuration profile"},
+		{"configuration read error", RestAPIMockErrors{}, []string{"configuration:0", valid}, "Error reading cluster configuration"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			captured, err := capture.StandardOutput(func() {
+				err := commands.Diff(tc.api, tc.args)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/drift.html
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/RedHatInsights/insights-operator-cli/backup"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// kinds of drift between local directory and controller
+const (
+	driftMissing  = "missing"
+	driftExtra    = "extra"
+	driftModified = "modified"
+)
+
+// localDocument represents JSON file stored in local directory
+type localDocument struct {
+	// path relative to the directory
+	name string
+
+	// file name without extension, it is compared with descriptions
+	key string
+
+	hash    string
+	content string
+}
+
+// remoteDocument represents configuration profile or active cluster
+// configuration stored in controller
+type remoteDocument struct {
+	label       string
+	description string
+	hash        string
+	content     string
+	matched     bool
+}
+
+// driftEntry represents one difference between local directory and
+// controller
+type driftEntry struct {
+	kind   string
+	local  *localDocument
+	remote *remoteDocument
+}
+
+// documentHash function returns hash of normalized JSON document, so
+// formatting and order of keys don't matter. Documents that are not valid
+// JSON are hashed as they are.
+func documentHash(content []byte) string {
+	normalized := string(content)
+	document, err := normalizeJSON(content)
+	if err == nil {
+		normalized = compactJSON(document)
+	}
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
+
+// readLocalDocuments function reads all JSON files from directory and its
+// subdirectories
+func readLocalDocuments(directory string) ([]localDocument, error) {
+	documents := []localDocument{}
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		content, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, localDocument{
+			name:    name,
+			key:     strings.TrimSuffix(info.Name(), ".json"),
+			hash:    documentHash(content),
+			content: string(content),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].name < documents[j].name
+	})
+	return documents, nil
+}
+
+// remoteDocuments function returns all configuration profiles and active
+// cluster configurations from controller snapshot
+func remoteDocuments(snapshot *backup.Snapshot) []*remoteDocument {
+	documents := []*remoteDocument{}
+	profileDescriptions := map[string]string{}
+	for _, profile := range snapshot.Profiles {
+		profileDescriptions[strconv.Itoa(profile.ID)] = profile.Description
+		documents = append(documents, &remoteDocument{
+			label:       fmt.Sprintf("profile %d %q", profile.ID, profile.Description),
+			description: profile.Description,
+			hash:        documentHash([]byte(profile.Configuration)),
+			content:     profile.Configuration,
+		})
+	}
+	// history of configurations is not a drift, only active
+	// configurations are compared
+	for _, configuration := range snapshot.Configurations {
+		if configuration.Active != "1" {
+			continue
+		}
+		description := profileDescriptions[configuration.Configuration]
+		documents = append(documents, &remoteDocument{
+			label: fmt.Sprintf("configuration %d for cluster %s (profile %q)",
+				configuration.ID, configuration.Cluster, description),
+			description: description,
+			hash:        documentHash([]byte(configuration.Payload)),
+			content:     configuration.Payload,
+		})
+	}
+	return documents
+}
+
+// findDrift function matches local documents with remote ones. Documents
+// with the same content are in sync. Documents with different content are
+// matched by file name and description and reported as modified. All other
+// documents are reported as missing (in controller) or extra.
+func findDrift(local []localDocument, remote []*remoteDocument) []driftEntry {
+	entries := []driftEntry{}
+	unmatched := []*localDocument{}
+
+	for i := range local {
+		document := &local[i]
+		found := false
+		for _, candidate := range remote {
+			if candidate.hash == document.hash {
+				candidate.matched = true
+				found = true
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, document)
+		}
+	}
+
+	for _, document := range unmatched {
+		found := false
+		for _, candidate := range remote {
+			if candidate.description == document.key {
+				candidate.matched = true
+				found = true
+				entries = append(entries, driftEntry{driftModified, document, candidate})
+			}
+		}
+		if !found {
+			entries = append(entries, driftEntry{driftMissing, document, nil})
+		}
+	}
+
+	for _, candidate := range remote {
+		if !candidate.matched {
+			entries = append(entries, driftEntry{driftExtra, nil, candidate})
+		}
+	}
+	return entries
+}
+
+// countDrift function returns number of drift entries of given kind
+func countDrift(entries []driftEntry, kind string) int {
+	count := 0
+	for _, entry := range entries {
+		if entry.kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// printDriftEntry function displays one difference between local directory
+// and controller, optionally together with diff of modified documents
+func printDriftEntry(entry driftEntry, showDiff bool) {
+	switch entry.kind {
+	case driftMissing:
+		fmt.Println(" ", colorizer.Red("-"), fmt.Sprintf("%-9s", driftMissing), entry.local.name)
+	case driftExtra:
+		fmt.Println(" ", colorizer.Green("+"), fmt.Sprintf("%-9s", driftExtra), entry.remote.label)
+	case driftModified:
+		fmt.Println(" ", colorizer.Yellow("~"), fmt.Sprintf("%-9s", driftModified),
+			entry.local.name+": "+entry.remote.label)
+		if showDiff {
+			printPayloadDiff(entry.remote.content, entry.local.content)
+		}
+	}
+}
+
+// Drift function compares JSON files stored in local directory with
+// configuration profiles and active configurations stored in controller.
+// Error is returned when any difference is found, so the command can be
+// used in periodic jobs.
+func Drift(api restapi.API, args []string) error {
+	flags := newFlagSet("drift")
+	directory := flags.String("dir", configurationsDirectory, "directory with JSON files")
+	showDiff := flags.Bool("diff", false, "show differences of modified documents")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return reportInvalidArguments("unexpected arguments: use --dir flag to specify directory")
+	}
+
+	local, err := readLocalDocuments(*directory)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadAnyConfigurationFileErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	snapshot, err := backup.Collect(api)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	entries := findDrift(local, remoteDocuments(snapshot))
+	if len(entries) == 0 {
+		fmt.Println(colorizer.Blue(fmt.Sprintf("No drift found, all %d files match the controller", len(local))))
+		return nil
+	}
+
+	fmt.Println(colorizer.Magenta("Drift between directory " + *directory + " and controller"))
+	for _, entry := range entries {
+		printDriftEntry(entry, *showDiff)
+	}
+	fmt.Println()
+	fmt.Printf("Drift: %d missing, %d extra, %d modified\n", countDrift(entries, driftMissing),
+		countDrift(entries, driftExtra), countDrift(entries, driftModified))
+
+	return fmt.Errorf("%d differences between directory %s and controller", len(entries), *directory)
+}
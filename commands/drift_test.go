@@ -0,0 +1,202 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking drift detection between local directory and
+// controller.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/drift_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// driftMock is an implementation of mocked REST API with profiles and
+// configurations that contain JSON payloads
+type driftMock struct {
+	RestAPIMock
+}
+
+// driftProfiles contains profiles returned by driftMock
+var driftProfiles = []types.ConfigurationProfile{
+	{ID: 1, Configuration: `{"a":1}`, Description: "configuration1"},
+	{ID: 2, Configuration: `{"b":2}`, Description: "other"},
+}
+
+// driftPayloads contains payloads of configurations returned by driftMock
+var driftPayloads = map[string]string{
+	"5": `{"a":1}`,
+	"6": `{"z":0}`,
+	"7": `{"b":2}`,
+}
+
+// ReadListOfConfigurationProfiles returns profiles without payloads
+func (api driftMock) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	return driftProfiles, nil
+}
+
+// ReadConfigurationProfile returns profile with payload
+func (api driftMock) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	for _, profile := range driftProfiles {
+		if profileID == strconv.Itoa(profile.ID) {
+			return &profile, nil
+		}
+	}
+	return &types.ConfigurationProfile{}, nil
+}
+
+// ReadListOfConfigurations returns two active configurations and one
+// inactive
+func (api driftMock) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	return []types.ClusterConfiguration{
+		{ID: 5, Cluster: "cluster-x", Configuration: "1", Active: "1"},
+		{ID: 6, Cluster: "cluster-x", Configuration: "1", Active: "0"},
+		{ID: 7, Cluster: "cluster-y", Configuration: "2", Active: "1"},
+	}, nil
+}
+
+// ReadClusterConfigurationByID returns payload of configuration
+func (api driftMock) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	payload := driftPayloads[configurationID]
+	return &payload, nil
+}
+
+// writeDirectory is a helper function that stores given files into
+// temporary directory
+func writeDirectory(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return directory
+}
+
+// runDrift is a helper function that runs drift command and returns its
+// output and error
+func runDrift(t *testing.T, args []string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	var driftErr error
+	captured, err := capture.StandardOutput(func() {
+		driftErr = commands.Drift(driftMock{}, args)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	return captured, driftErr
+}
+
+// TestDriftNoDrift function checks the drift command when all files match
+// the controller, regardless of formatting.
+func TestDriftNoDrift(t *testing.T) {
+	directory := writeDirectory(t, map[string]string{
+		"configuration1.json": "{\n    \"a\": 1\n}\n",
+		"other.json":          `{"b": 2}`,
+		"README.md":           "not compared",
+	})
+
+	captured, err := runDrift(t, []string{"--dir", directory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "No drift found, all 2 files match the controller") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDrift function checks that missing, extra and modified documents are
+// reported and that error is returned.
+func TestDrift(t *testing.T) {
+	directory := writeDirectory(t, map[string]string{
+		"configuration1.json": `{"a": 2}`,
+		"new.json":            `{"n": 1}`,
+	})
+
+	captured, err := runDrift(t, []string{"--dir", directory, "--diff"})
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+
+	expected := []string{
+		`~ modified  configuration1.json: profile 1 "configuration1"`,
+		`~ modified  configuration1.json: configuration 5 for cluster cluster-x (profile "configuration1")`,
+		"~ a: 1 -> 2",
+		"- missing   new.json",
+		`+ extra     profile 2 "other"`,
+		`+ extra     configuration 7 for cluster cluster-y (profile "other")`,
+		"Drift: 1 missing, 2 extra, 2 modified",
+	}
+	for _, line := range expected {
+		if !strings.Contains(captured, line) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+	// history of configurations is not compared
+	if strings.Contains(captured, "configuration 6") {
+		t.Fatal("Inactive configuration should not be reported:\n", captured)
+	}
+}
+
+// TestDriftMissingDirectory function checks the drift command when
+// directory does not exist.
+func TestDriftMissingDirectory(t *testing.T) {
+	captured, err := runDrift(t, []string{"--dir", "/does/not/exist"})
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.Contains(captured, commands.CannotReadAnyConfigurationFileErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDriftError function checks the drift command when REST API returns
+// an error.
+func TestDriftError(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	directory := writeDirectory(t, map[string]string{})
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.Drift(RestAPIMockErrors{}, []string{"--dir", directory})
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.ErrorCommunicationWithServiceErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/editor.html
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/kballard/go-shellquote"
+)
+
+// editorEnvironmentVariable is name of environment variable that contains
+// command used to start text editor (it can contain flags, for example
+// "code --wait")
+const editorEnvironmentVariable = "EDITOR"
+
+// defaultEditor is used when editor is not configured
+const defaultEditor = "vi"
+
+// editorCommand function returns command (with optional arguments) used to
+// start the text editor
+func editorCommand() ([]string, error) {
+	editor := os.Getenv(editorEnvironmentVariable)
+	if editor == "" {
+		editor = defaultEditor
+	}
+
+	command, err := shellquote.Split(editor)
+	if err != nil {
+		return nil, err
+	}
+	if len(command) == 0 {
+		return nil, errors.New("editor command is empty")
+	}
+	return command, nil
+}
+
+// editText function stores given content into temporary file, opens it in
+// text editor, and returns the content of file after the editor is closed.
+// Pattern is used to construct name of temporary file (see os.CreateTemp).
+func editText(content []byte, pattern string) ([]byte, error) {
+	command, err := editorCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	fileName := file.Name()
+	defer func() {
+		_ = os.Remove(fileName)
+	}()
+
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// editor needs to be connected to the terminal
+	// disable "G204 (CWE-78): Subprocess launched with variable"
+	cmd := exec.Command(command[0], append(command[1:], fileName)...) // #nosec G204
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	return os.ReadFile(fileName) // #nosec G304
+}
+
+// prettyPrintJSON function returns indented version of JSON document. The
+// original content is returned when it is not valid JSON, so the user is
+// still able to fix it.
+func prettyPrintJSON(content []byte) []byte {
+	var buffer bytes.Buffer
+	err := json.Indent(&buffer, content, "", "    ")
+	if err != nil {
+		return content
+	}
+	buffer.WriteByte('\n')
+	return buffer.Bytes()
+}
+
+// validateJSON function checks if the content is valid JSON document
+func validateJSON(content []byte) error {
+	var document interface{}
+	return json.Unmarshal(content, &document)
+}
+
+// retryEditing function decides whether user can return back to editor when
+// the edited document is not valid. It does not depend on confirmation
+// setting, the question is asked whenever user is able to answer it.
+var retryEditing = stdinIsTerminal
+
+// editJSONDocument function lets user edit the JSON document in text editor
+// and displays diff between the original and edited document. When the
+// edited document is not valid, user can return back to editor so the changes
+// are not lost. Nil document is returned when nothing has been changed.
+func editJSONDocument(document []byte, pattern string) ([]byte, error) {
+	original := prettyPrintJSON(document)
+	content := original
+
+	for {
+		edited, err := editText(content, pattern)
+		if err != nil {
+			fmt.Println(colorizer.Red(CannotRunEditorErrorMessage))
+			fmt.Println(err)
+			return nil, err
+		}
+
+		diff := lineDiff(string(original), string(edited))
+		if !diffChanged(diff) {
+			fmt.Println(colorizer.Blue(noChanges))
+			return nil, nil
+		}
+
+		err = validateJSON(edited)
+		if err == nil {
+			printLineDiff(diff)
+			return edited, nil
+		}
+
+		fmt.Println(colorizer.Red(InvalidJSONErrorMessage))
+		fmt.Println(err)
+		if !retryEditing() || !ProceedQuestion("Edit the document again?") {
+			return nil, err
+		}
+		// let the user fix the document he/she has already edited
+		content = edited
+	}
+}
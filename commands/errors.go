@@ -22,6 +22,8 @@ package commands
 // Documentation in literate-programming-style is available at:
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/errors.html
 
+import "errors"
+
 // constants used to display various error messages
 const (
 	CannotReadConfigurationFileErrorMessage    = "Cannot read configuration file"
@@ -34,4 +36,61 @@ const (
 	ErrorReadingConfigurationProfile           = "Error reading configuration profile"
 	ErrorReadingListOfTriggers                 = "Error reading list of triggers"
 	ErrorReadingSelectedTrigger                = "Error reading selected trigger"
+	InvalidCommandArgumentsErrorMessage        = "Invalid command arguments"
+	CannotSelectItemsErrorMessage              = "Can not select items for the operation"
+	CannotResolveClusterErrorMessage           = "Can not resolve cluster"
+	CannotRunEditorErrorMessage                = "Can not run text editor"
+	InvalidJSONErrorMessage                    = "Edited document is not valid JSON"
+	CannotActivateConfigurationErrorMessage    = "Can not activate configuration"
+	CannotReadDocumentErrorMessage             = "Can not read document to be compared"
+	CannotRollbackErrorMessage                 = "Can not rollback cluster configuration"
+	CannotWriteBackupErrorMessage              = "Can not write backup archive"
+	CannotReadBackupErrorMessage               = "Can not read backup archive"
+	CannotReadManifestErrorMessage             = "Can not read manifest"
+	CannotApplyPlanErrorMessage                = "Can not apply plan"
+	CannotIdentifyTriggerErrorMessage          = "Can not identify created trigger"
+	CannotAccessSchedulesErrorMessage          = "Can not access scheduled jobs"
+	DuplicateTriggerErrorMessage               = "Trigger has not been created, the cluster already has pending trigger of the same type"
+	CannotStartServerErrorMessage              = "Can not start server"
+	CannotReadTokenFileErrorMessage            = "Can not read token file"
+	CannotSendNotificationsErrorMessage        = "Can not send notifications"
+	CannotReadAuditLogErrorMessage             = "Can not read audit log"
+)
+
+// exit statuses of commands executed from command line
+const (
+	ExitStatusFailure     = 1
+	ExitStatusTimeout     = 3
+	ExitStatusDeactivated = 4
+	ExitStatusBreach      = 5
 )
+
+// StatusError represents an error that is reported by specific exit status
+// when the command is executed from command line
+type StatusError struct {
+	Status int
+	Err    error
+}
+
+// Error method returns the message of wrapped error.
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap method returns the wrapped error.
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// ExitStatus function returns exit status that corresponds to the result of
+// command. Errors without specific status are reported by ExitStatusFailure.
+func ExitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	var statusError *StatusError
+	if errors.As(err, &statusError) {
+		return statusError.Status
+	}
+	return ExitStatusFailure
+}
@@ -0,0 +1,81 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/export_test.html
+
+import "time"
+
+// Export for testing
+//
+// This source file contains name aliases of all package-private functions
+// that need to be called from unit tests. Aliases should start with uppercase
+// letter because unit tests belong to different package.
+//
+// Please look into the following blogpost:
+// https://medium.com/@robiplus/golang-trick-export-for-test-aa16cbd7b8cd
+// to see why this trick is needed for using package internal
+// symbols (externally invisible) in unit tests.
+var (
+	ParseIDList                = parseIDList
+	FormatLink                 = formatLink
+	TerminalSupportsHyperlinks = terminalSupportsHyperlinks
+	ParseAge                   = parseAge
+	CheckStateChanges          = checkStateChanges
+	FlushNotifications         = flushNotifications
+	LoopbackAddress            = loopbackAddress
+	ExactClusterName           = exactClusterName
+	DuplicateAlertTrigger      = duplicateAlertTrigger
+	PrintLineDiff              = func(oldText, newText string) {
+		printLineDiff(lineDiff(oldText, newText))
+	}
+)
+
+// UseFakeClock function replaces clock used by commands by a fake one that is
+// moved forward by sleeping, the returned function restores the original clock
+func UseFakeClock(start time.Time) func() {
+	now := start
+	originalTime, originalSleep, originalAfter := currentTime, sleep, after
+	currentTime = func() time.Time {
+		return now
+	}
+	sleep = func(duration time.Duration) {
+		now = now.Add(duration)
+	}
+	after = func(duration time.Duration) <-chan time.Time {
+		now = now.Add(duration)
+		elapsed := make(chan time.Time, 1)
+		elapsed <- now
+		return elapsed
+	}
+	return func() {
+		currentTime, sleep, after = originalTime, originalSleep, originalAfter
+	}
+}
+
+// SetCurrentTime function replaces current time used by commands, the
+// returned function restores the original clock
+func SetCurrentTime(current time.Time) func() {
+	original := currentTime
+	currentTime = func() time.Time {
+		return current
+	}
+	return func() {
+		currentTime = original
+	}
+}
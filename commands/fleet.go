@@ -0,0 +1,204 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/fleet.html
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+	"github.com/c-bata/go-prompt"
+)
+
+// selectorItems function returns items of cluster selector. Items are
+// separated by comma or they are read from file (one item per line) when the
+// selector starts with @.
+func selectorItems(selector string) ([]string, error) {
+	if !strings.HasPrefix(selector, "@") {
+		return strings.Split(selector, ","), nil
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	content, err := os.ReadFile(strings.TrimPrefix(selector, "@")) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	items := []string{}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		// empty lines and comments are skipped
+		if line != "" && !strings.HasPrefix(line, "#") {
+			items = append(items, line)
+		}
+	}
+	return items, nil
+}
+
+// expandClusterSelector function returns names of all clusters selected by
+// selector. Each item of selector is either shell pattern (like 0000*) or
+// cluster specified by its name, numeric ID or unambiguous prefix of name.
+func expandClusterSelector(clusters []types.Cluster, selector string) ([]string, error) {
+	items, err := selectorItems(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := map[string]bool{}
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if !strings.ContainsAny(item, "*?[") {
+			cluster, err := findCluster(clusters, item)
+			if err != nil {
+				return nil, err
+			}
+			selected[cluster.Name] = true
+			continue
+		}
+
+		matched := false
+		for _, cluster := range clusters {
+			match, err := path.Match(item, cluster.Name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %v", item, err)
+			}
+			if match {
+				selected[cluster.Name] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("pattern %q does not match any cluster", item)
+		}
+	}
+
+	// clusters are returned in the order used by controller
+	names := []string{}
+	for _, cluster := range clusters {
+		if selected[cluster.Name] {
+			names = append(names, cluster.Name)
+			delete(selected, cluster.Name)
+		}
+	}
+	return names, nil
+}
+
+// skippedCluster represents selected cluster that already has pending
+// trigger
+type skippedCluster struct {
+	name    string
+	trigger int
+}
+
+// selectFleetClusters function returns clusters selected by --clusters
+// selector or all clusters for --all flag, clusters with pending trigger of
+// given type are returned separately unless force is set
+func selectFleetClusters(api restapi.API, selector, triggerType string, force bool) ([]string, []skippedCluster, error) {
+	clusters, err := api.ReadListOfClusters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var names []string
+	if selector == "" {
+		for _, cluster := range clusters {
+			names = append(names, cluster.Name)
+		}
+	} else {
+		names, err = expandClusterSelector(clusters, selector)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if force {
+		return names, nil, nil
+	}
+
+	list, err := api.ReadListOfTriggers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targets := []string{}
+	skipped := []skippedCluster{}
+	for _, name := range names {
+		if trigger := pendingTrigger(list, name, triggerType); trigger != nil {
+			skipped = append(skipped, skippedCluster{name, trigger.ID})
+		} else {
+			targets = append(targets, name)
+		}
+	}
+	return targets, skipped, nil
+}
+
+// addFleetTriggers function creates trigger of given type with shared
+// reason, link and parameters for all selected clusters. Clusters that
+// already have pending trigger of the same type are skipped unless force is
+// set. Triggers are created concurrently and result is reported for each
+// cluster.
+func addFleetTriggers(api restapi.API, username string, triggerType *triggers.Type, parameters []byte,
+	options *triggerFlags, selector string, bulk bulkOptions, force, askForConfirmation bool) error {
+	targets, skipped, err := selectFleetClusters(api, selector, triggerType.Name, force)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotSelectItemsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	for _, cluster := range skipped {
+		fmt.Println(colorizer.Yellow(clusterMessage+cluster.name+" skipped:"),
+			fmt.Sprintf("trigger %d of type %s has not been acknowledged yet", cluster.trigger, triggerType.Name))
+	}
+
+	if len(targets) == 0 {
+		fmt.Println(colorizer.Blue(nothingSelected))
+		return nil
+	}
+
+	fmt.Println(colorizer.Magenta(fmt.Sprintf("Trigger %s will be created for %d clusters", triggerType.Name, len(targets))))
+	for _, name := range targets {
+		fmt.Println("   ", name)
+	}
+
+	if options.reason == "" {
+		options.reason = prompt.Input(reasonPrompt, LoginCompleter)
+	}
+	if askForConfirmation {
+		if !ProceedQuestion(fmt.Sprintf("%d triggers will be created", len(targets))) {
+			return nil
+		}
+	}
+
+	results := runBulkOperation(targets, bulk, func(cluster string) error {
+		return api.AddTypedTrigger(username, cluster, triggerType.Name, options.reason, options.link, parameters)
+	})
+	return reportBulkResults(results, triggerMessage+triggerType.Name+" for cluster ", colorizer.Green("created"))
+}
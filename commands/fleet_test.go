@@ -0,0 +1,217 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking creation of triggers for more clusters at once.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/fleet_test.html
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// fleetMock is an implementation of mocked REST API with more clusters that
+// records created triggers, triggers for cluster "prod-3" can not be created
+type fleetMock struct {
+	RestAPIMock
+	mutex   *sync.Mutex
+	created *[]string
+}
+
+// newFleetMock function constructs new mocked REST API
+func newFleetMock() fleetMock {
+	return fleetMock{mutex: &sync.Mutex{}, created: &[]string{}}
+}
+
+// ReadListOfClusters returns mocked list of clusters
+func (api fleetMock) ReadListOfClusters() ([]types.Cluster, error) {
+	return []types.Cluster{
+		{ID: 1, Name: "prod-1"},
+		{ID: 2, Name: "prod-2"},
+		{ID: 3, Name: "prod-3"},
+		{ID: 4, Name: "stage-1"},
+		{ID: 5, Name: "stage-2"},
+	}, nil
+}
+
+// ReadListOfTriggers returns pending must-gather trigger for prod-2,
+// acknowledged one for prod-1 and inactive one for stage-1
+func (api fleetMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	return []types.Trigger{
+		{ID: 6, Type: "must-gather", Cluster: "prod-1", Active: 1, AckedAt: "2020-01-01T00:00:00"},
+		{ID: 7, Type: "must-gather", Cluster: "prod-2", Active: 1},
+		{ID: 8, Type: "must-gather", Cluster: "stage-1", Active: 0},
+		{ID: 9, Type: "custom-gather", Cluster: "stage-2", Active: 1},
+	}, nil
+}
+
+// AddTypedTrigger records the created trigger
+func (api fleetMock) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	if clusterName == "prod-3" {
+		return errors.New("cluster is not reachable")
+	}
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	*api.created = append(*api.created, strings.Join([]string{clusterName, triggerType, reason, link, username}, " "))
+	return nil
+}
+
+// createdTriggers returns created triggers sorted by cluster
+func (api fleetMock) createdTriggers() []string {
+	created := append([]string{}, *api.created...)
+	sort.Strings(created)
+	return created
+}
+
+// runFleetCommand is a helper function that runs request must-gather
+// command and returns its output and error
+func runFleetCommand(t *testing.T, api fleetMock, args ...string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer useTriggerTypes(t)()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.RequestMustGather(api, "tester", args, false)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// TestFleetPattern function checks that clusters are selected by pattern and
+// clusters with pending trigger are skipped, active trigger that has been
+// acknowledged already does not matter.
+func TestFleetPattern(t *testing.T) {
+	api := newFleetMock()
+	captured, err := runFleetCommand(t, api, "--clusters", "prod-*,stage-1", "--reason", "incident", "--link", "https://issue")
+	if err == nil {
+		t.Fatal("Failure for prod-3 should be reported")
+	}
+
+	expected := []string{"prod-1 must-gather incident https://issue tester", "stage-1 must-gather incident https://issue tester"}
+	if strings.Join(api.createdTriggers(), "\n") != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected triggers:", *api.created)
+	}
+
+	expectedOutput := `Cluster prod-2 skipped: trigger 7 of type must-gather has not been acknowledged yet
+Trigger must-gather will be created for 3 clusters
+    prod-1
+    prod-3
+    stage-1
+Trigger must-gather for cluster prod-1 has been created
+Trigger must-gather for cluster prod-3: cluster is not reachable
+Trigger must-gather for cluster stage-1 has been created
+2 succeeded, 1 failed
+`
+	if captured != expectedOutput {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestFleetAll function checks that all clusters can be selected.
+func TestFleetAll(t *testing.T) {
+	api := newFleetMock()
+	_, err := runFleetCommand(t, api, "--all", "--type", "custom-gather", "--reason", "r", "--workers", "2")
+	if err == nil {
+		t.Fatal("Failure for prod-3 should be reported")
+	}
+	// stage-2 has pending trigger of the same type
+	if len(*api.created) != 3 {
+		t.Fatal("Unexpected triggers:", *api.created)
+	}
+}
+
+// TestFleetFile function checks that clusters can be read from file.
+func TestFleetFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "clusters.txt")
+	err := os.WriteFile(fileName, []byte("# clusters affected by incident\nprod-1\n\n5\nstage-1\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := newFleetMock()
+	_, err = runFleetCommand(t, api, "--clusters", "@"+fileName, "--reason", "r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"prod-1 must-gather r  tester", "stage-1 must-gather r  tester", "stage-2 must-gather r  tester"}
+	if strings.Join(api.createdTriggers(), "\n") != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected triggers:", *api.created)
+	}
+}
+
+// TestFleetNothingSelected function checks the situation when all selected
+// clusters have pending trigger.
+func TestFleetNothingSelected(t *testing.T) {
+	api := newFleetMock()
+	captured, err := runFleetCommand(t, api, "--clusters", "prod-2", "--reason", "r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(captured, "No items match the selection\n") || len(*api.created) != 0 {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestFleetForce function checks that clusters with pending trigger are not
+// skipped when --force flag is used.
+func TestFleetForce(t *testing.T) {
+	api := newFleetMock()
+	captured, err := runFleetCommand(t, api, "--clusters", "prod-1,prod-2", "--reason", "r", "--force")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"prod-1 must-gather r  tester", "prod-2 must-gather r  tester"}
+	if strings.Join(api.createdTriggers(), "\n") != strings.Join(expected, "\n") || strings.Contains(captured, "skipped") {
+		t.Fatal("Unexpected triggers:", *api.created, captured)
+	}
+}
+
+// TestFleetInvalidSelection function checks that invalid selections are
+// reported and no trigger is created.
+func TestFleetInvalidSelection(t *testing.T) {
+	invalid := [][]string{
+		{"--clusters", "dev-*"},
+		{"--clusters", "prod"},
+		{"--clusters", "[prod"},
+		{"--clusters", "@missing.txt"},
+		{"--clusters", "prod-1", "--all"},
+		{"--clusters", "prod-1", "prod-2"},
+		{"--all", "--wait"},
+	}
+	for _, args := range invalid {
+		api := newFleetMock()
+		_, err := runFleetCommand(t, api, append(args, "--reason", "r")...)
+		if err == nil || len(*api.created) != 0 {
+			t.Error("Selection should be refused:", args)
+		}
+	}
+}
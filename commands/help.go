@@ -39,9 +39,14 @@ func PrintHelp() {
 	// cluster manipulation commands
 	fmt.Println(colorizer.Blue("Cluster operations:        "))
 	fmt.Println(colorizer.Yellow("list clusters            "), "list all clusters known to the service")
+	fmt.Println(colorizer.Yellow("describe cluster ##      "), "show cluster with its configurations and triggers")
 	fmt.Println(colorizer.Yellow("delete cluster ##        "), "delete selected cluster")
 	fmt.Println(colorizer.Yellow("add cluster              "), "create new cluster")
 	fmt.Println(colorizer.Yellow("new cluster              "), commandAlias)
+	fmt.Println(colorizer.Yellow("history cluster ##       "), "show configurations of cluster in chronological order (--diff shows changes)")
+	fmt.Println(colorizer.Yellow("rollback cluster ##      "), "make previous configuration active again (--to ID or --steps N)")
+	fmt.Println("Clusters can be specified by name (UUID), numeric ID or unambiguous prefix of name")
+	fmt.Println("Use", colorizer.Yellow("--output json"), "or", colorizer.Yellow("--output yaml"), "for machine-readable output")
 	fmt.Println()
 
 	// configuration profiles manipulation commands
@@ -50,6 +55,8 @@ func PrintHelp() {
 	fmt.Println(colorizer.Yellow("describe profile ##      "), "describe profile selected by its ID")
 	fmt.Println(colorizer.Yellow("add profile              "), "create new configuration profile")
 	fmt.Println(colorizer.Yellow("delete profile ##        "), "delete profile selected by its ID")
+	fmt.Println(colorizer.Yellow("edit profile ##          "), "edit profile selected by its ID in $EDITOR and upload it")
+	fmt.Println(colorizer.Yellow("clone profile ##         "), "create copy of profile (--description, --edit)")
 	fmt.Println()
 
 	// cluster configuraration commands
@@ -60,22 +67,87 @@ func PrintHelp() {
 	fmt.Println(colorizer.Yellow("new configuration        "), commandAlias)
 	fmt.Println(colorizer.Yellow("enable configuration ##  "), "enable cluster configuration selected by its ID")
 	fmt.Println(colorizer.Yellow("disable configuration ## "), "disable cluster configuration selected by its ID")
+	fmt.Println(colorizer.Yellow("activate configuration ##"), "enable configuration and disable other ones for the same cluster")
 	fmt.Println(colorizer.Yellow("delete configuration ##  "), "delete configuration selected by its ID")
+	fmt.Println(colorizer.Yellow("edit configuration ##    "), "edit configuration in $EDITOR and activate it as a new one")
+	fmt.Println(colorizer.Yellow("clone configuration ##   "), "create copy of configuration for --to-cluster (--reason, --edit)")
 	fmt.Println()
 
 	// must-gather triggering related commands
 	fmt.Println(colorizer.Blue("Must-gather trigger:       "))
 	fmt.Println(colorizer.Yellow("list triggers            "), "list all triggers")
-	fmt.Println(colorizer.Yellow("describe trigger ##      "), "describe trigger selected by its ID")
+	fmt.Println(colorizer.Yellow("describe trigger ##      "), "describe trigger including its reason, link, parameters and time to ack")
 	fmt.Println(colorizer.Yellow("add trigger              "), "add new trigger")
 	fmt.Println(colorizer.Yellow("new trigger              "), commandAlias)
+	fmt.Println(colorizer.Yellow("add trigger ## --type T  "), "add trigger of given type (--param key=value, --params-file, --reason, --link, --force)")
+	fmt.Println(colorizer.Yellow("request must-gather ##   "), "request must-gather (--wait blocks until it is acknowledged, --timeout 30m)")
+	fmt.Println(colorizer.Yellow("request must-gather --clusters <pattern|@file>"), "request must-gather for more clusters (or --all)")
 	fmt.Println(colorizer.Yellow("activate trigger ##      "), "activate trigger selected by its ID")
 	fmt.Println(colorizer.Yellow("deactivate trigger ##    "), "deactivate trigger selected by its ID")
 	fmt.Println(colorizer.Yellow("delete trigger ##        "), "delete trigger selected by its ID")
+	fmt.Println(colorizer.Yellow("prune triggers --older-than 7d"), "deactivate triggers not acknowledged for given time (or --delete)")
+	fmt.Println(colorizer.Yellow("report triggers          "), "time to ack per cluster and requester, triggers not acked within --threshold 1h")
+	fmt.Println(colorizer.Yellow("serve alert-receiver     "), "create must-gather triggers for Alertmanager alerts (--listen 127.0.0.1:9099)")
+	fmt.Println()
+
+	// comparing profiles, configurations and files
+	fmt.Println(colorizer.Blue("Comparing documents:       "))
+	fmt.Println(colorizer.Yellow("diff <a> <b>             "), "show differences between two documents")
+	fmt.Println("Document can be", colorizer.Yellow("profile:ID"), "or", colorizer.Yellow("configuration:ID"), "or file name")
+	fmt.Println("Use", colorizer.Yellow("--format unified"), "or", colorizer.Yellow("--format patch"), "for unified diff or JSON patch")
+	fmt.Println(colorizer.Yellow("drift --dir <path>       "), "compare JSON files in directory with controller (--diff shows changes)")
+	fmt.Println()
+
+	// backup and restore of controller state
+	fmt.Println(colorizer.Blue("Backup and restore:        "))
+	fmt.Println(colorizer.Yellow("export                   "), "export state of controller into archive (--out file)")
+	fmt.Println(colorizer.Yellow("import <file>            "), "create all objects from archive in controller")
+	fmt.Println()
+
+	// declarative management of controller state
+	fmt.Println(colorizer.Blue("Declarative management:    "))
+	fmt.Println(colorizer.Yellow("plan -f <manifest>       "), "show actions needed to reach the state described by manifest")
+	fmt.Println(colorizer.Yellow("apply -f <manifest>      "), "perform the actions after confirmation (--prune deletes other clusters)")
+	fmt.Println()
+
+	// bulk operations
+	fmt.Println(colorizer.Blue("Bulk operations:           "))
+	fmt.Println("Commands delete/enable/disable configuration and delete/activate/deactivate")
+	fmt.Println("trigger accept list of IDs, for example", colorizer.Yellow("delete trigger 3,5,9-20"))
+	fmt.Println("Configurations can be selected by", colorizer.Yellow("--cluster <name>"),
+		"and triggers by", colorizer.Yellow("--cluster <name>"), "or", colorizer.Yellow("--all-acked"))
+	fmt.Println("Use", colorizer.Yellow("--workers N"), "and", colorizer.Yellow("--rate N"),
+		"to set concurrency and maximum number of operations per second")
+	fmt.Println()
+
+	// watching lists
+	fmt.Println(colorizer.Blue("Watching changes:          "))
+	fmt.Println(colorizer.Yellow("watch list triggers      "), "refresh list periodically and highlight changes (--interval 5s)")
+	fmt.Println("Any of", colorizer.Yellow("list clusters/profiles/configurations/triggers"), "commands can be watched, Ctrl+C stops watching")
+	fmt.Println()
+
+	// scheduled jobs
+	fmt.Println(colorizer.Blue("Scheduled jobs:            "))
+	fmt.Println(colorizer.Yellow("schedule --cron <expr> <command>"), "run command repeatedly, for example --cron \"0 2 * * *\"")
+	fmt.Println(colorizer.Yellow("schedule --at <time> <command>  "), "run command once, for example --at \"2026-10-20 02:00\"")
+	fmt.Println(colorizer.Yellow("list schedules           "), "list all scheduled jobs with results of their last run")
+	fmt.Println(colorizer.Yellow("cancel schedule ##       "), "cancel scheduled job selected by its ID")
+	fmt.Println(colorizer.Yellow("daemon                   "), "run scheduled jobs at their time (--once runs just the due ones)")
+	fmt.Println("Commands", colorizer.Yellow("request must-gather, add trigger, enable/disable configuration"), "and",
+		colorizer.Yellow("apply"), "can be scheduled")
+	fmt.Println("Watch and daemon modes send notifications about state changes to", colorizer.Yellow("webhooks"), "set in configuration")
+	fmt.Println()
+
+	// audit log
+	fmt.Println(colorizer.Blue("Audit log:                 "))
+	fmt.Println(colorizer.Yellow("audit                    "), "show operations that changed controller state (--user, --os-user, --operation, --id)")
+	fmt.Println("Use", colorizer.Yellow("--cluster <name>"), ",", colorizer.Yellow("--failed"), ",", colorizer.Yellow("--since 7d"),
+		"and", colorizer.Yellow("--last N"), "to select operations,", colorizer.Yellow("--details"), "shows command line and controller")
 	fmt.Println()
 
 	// other commands
 	fmt.Println(colorizer.Blue("Other commands:"))
+	fmt.Println(colorizer.Yellow("check                    "), "find clusters without exactly one active configuration (--fix fixes them)")
 	fmt.Println(colorizer.Yellow("version                  "), "print version information")
 	fmt.Println(colorizer.Yellow("quit                     "), "quit the application")
 	fmt.Println(colorizer.Yellow("exit                     "), commandAlias)
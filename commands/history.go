@@ -0,0 +1,166 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/history.html
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// clusterHistory function returns all configurations for given cluster
+// ordered chronologically, the oldest configuration is the first one
+func clusterHistory(configurations []types.ClusterConfiguration, cluster string) []types.ClusterConfiguration {
+	history := []types.ClusterConfiguration{}
+	for _, configuration := range configurations {
+		if configuration.Cluster == cluster {
+			history = append(history, configuration)
+		}
+	}
+
+	// timestamps have fixed format, so they can be compared as strings;
+	// configurations changed at the same time are ordered by their IDs
+	sort.SliceStable(history, func(i, j int) bool {
+		if history[i].ChangedAt != history[j].ChangedAt {
+			return history[i].ChangedAt < history[j].ChangedAt
+		}
+		return history[i].ID < history[j].ID
+	})
+	return history
+}
+
+// readConfigurationPayload function reads payload of cluster configuration,
+// missing payload is considered to be empty
+func readConfigurationPayload(api restapi.API, configurationID int) (string, error) {
+	payload, err := api.ReadClusterConfigurationByID(strconv.Itoa(configurationID))
+	if err != nil {
+		return "", fmt.Errorf("%s %d: %v", ErrorReadingClusterConfiguration, configurationID, err)
+	}
+	if payload == nil {
+		return "", nil
+	}
+	return *payload, nil
+}
+
+// printPayloadDiff function displays differences between two configuration
+// payloads. Structural diff is used for JSON payloads, line-based diff is
+// used otherwise.
+func printPayloadDiff(oldPayload, newPayload string) {
+	oldDocument, oldErr := normalizeJSON([]byte(oldPayload))
+	newDocument, newErr := normalizeJSON([]byte(newPayload))
+
+	if oldErr == nil && newErr == nil {
+		changes := structuralDiff(oldDocument, newDocument)
+		if len(changes) == 0 {
+			fmt.Println(colorizer.Blue(noDifferences))
+			return
+		}
+		printStructuralDiff(changes, oldDocument, newDocument)
+		return
+	}
+
+	lines := lineDiff(oldPayload, newPayload)
+	if !diffChanged(lines) {
+		fmt.Println(colorizer.Blue(noDifferences))
+		return
+	}
+	printLineDiff(lines)
+}
+
+// printHistoryEntry function displays one configuration from cluster
+// history, the active configuration is highlighted
+func printHistoryEntry(version int, configuration types.ClusterConfiguration) {
+	line := fmt.Sprintf("%4d %4d %7s %-20s %-12s %s", version, configuration.ID,
+		configuration.Configuration, displayedTimestamp(configuration.ChangedAt), configuration.ChangedBy,
+		configuration.Reason)
+	if configuration.Active == "1" {
+		fmt.Println(colorizer.Green("*"), colorizer.Bold(line))
+	} else {
+		fmt.Println(" ", line)
+	}
+}
+
+// HistoryOfCluster function displays all configurations of selected cluster
+// in chronological order. With --diff flag, differences between each
+// configuration and the previous one are displayed too.
+func HistoryOfCluster(api restapi.API, args []string) error {
+	flags := newFlagSet("history cluster")
+	showDiff := flags.Bool("diff", false, "show differences between adjacent configurations")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		err = fmt.Errorf("exactly one cluster needs to be specified")
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	cluster, ok := resolveClusterOrReport(api, positional[0])
+	if !ok {
+		return errors.New(CannotResolveClusterErrorMessage)
+	}
+
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+	history := clusterHistory(configurations, cluster.Name)
+
+	fmt.Println(colorizer.Magenta("History of configurations for cluster " + cluster.Name))
+	if len(history) == 0 {
+		fmt.Println("none")
+		return nil
+	}
+	fmt.Printf("  %4s %4s %7s %-20s %-12s %s\n", "#", "ID", "Profile", changedAt, changedBy, "Reason")
+
+	previousPayload := ""
+	for i, configuration := range history {
+		printHistoryEntry(i+1, configuration)
+		if !*showDiff {
+			continue
+		}
+
+		payload, err := readConfigurationPayload(api, configuration.ID)
+		if err != nil {
+			fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+			fmt.Println(err)
+			return err
+		}
+		// the first configuration is not compared with anything
+		if i > 0 {
+			printPayloadDiff(previousPayload, payload)
+			fmt.Println()
+		}
+		previousPayload = payload
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking history of cluster configurations.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/history_test.html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// payloadMock is mocked REST API that returns configured payloads of
+// cluster configurations
+type payloadMock struct {
+	RestAPIMock
+	payloads map[string]string
+}
+
+// ReadClusterConfigurationByID returns configured payload
+func (api payloadMock) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	payload, found := api.payloads[configurationID]
+	if !found {
+		return nil, nil
+	}
+	return &payload, nil
+}
+
+// newPayloadMock function constructs mocked REST API with payloads for all
+// configurations of cluster 00000000-0000-0000-0000-000000000000
+func newPayloadMock() payloadMock {
+	return payloadMock{
+		payloads: map[string]string{
+			"0": `{"watch": ["a"]}`,
+			"1": `{"watch": ["a", "b"]}`,
+			"2": `not a JSON`,
+		},
+	}
+}
+
+// runHistory is a helper function that runs history command and returns
+// captured output
+func runHistory(t *testing.T, api restapi.API, args ...string) string {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.HistoryOfCluster(api, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured
+}
+
+// TestHistoryOfCluster function checks whether configurations are displayed
+// in chronological order and the active ones are marked.
+func TestHistoryOfCluster(t *testing.T) {
+	captured := runHistory(t, RestAPIMock{}, "1")
+
+	lines := strings.Split(strings.TrimSuffix(captured, "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if lines[0] != "History of configurations for cluster 00000000-0000-0000-0000-000000000000" {
+		t.Fatal("Unexpected title:", lines[0])
+	}
+
+	expected := []struct {
+		prefix string
+		reason string
+	}{
+		{"*    1    0", "configuration1"},
+		{"*    2    1", "configuration2"},
+		{"     3    2", "configuration3"},
+	}
+	for i, e := range expected {
+		line := lines[i+2]
+		if !strings.HasPrefix(line, e.prefix) || !strings.HasSuffix(line, e.reason) {
+			t.Fatalf("Unexpected history entry #%d: %s", i+1, line)
+		}
+	}
+}
+
+// TestHistoryOfClusterWithDiff function checks whether differences between
+// adjacent configurations are displayed.
+func TestHistoryOfClusterWithDiff(t *testing.T) {
+	captured := runHistory(t, newPayloadMock(), "1", "--diff")
+
+	expected := []string{
+		`+ watch[1]: "b"`,
+		`- {"watch": ["a", "b"]}`,
+		`+ not a JSON`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e+"\n") {
+			t.Fatalf("Line %s not found in output:\n%s", e, captured)
+		}
+	}
+
+	// the diff needs to follow the entry
+	if strings.Index(captured, "configuration2") > strings.Index(captured, `+ watch[1]: "b"`) {
+		t.Fatal("Diff is not displayed after the entry:\n", captured)
+	}
+}
+
+// TestHistoryOfClusterShortTimestamps function checks that timestamps that
+// can't be parsed are displayed as they are.
+func TestHistoryOfClusterShortTimestamps(t *testing.T) {
+	captured := runHistory(t, shortTimestampsMock{}, "1")
+
+	if strings.Count(captured, " 2020-01-01 ") != 3 {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestHistoryOfClusterWithoutConfigurations function checks the history of
+// cluster without any configuration.
+func TestHistoryOfClusterWithoutConfigurations(t *testing.T) {
+	captured := runHistory(t, RestAPIMock{}, "ffff")
+
+	if !strings.HasSuffix(captured, "\nnone\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestHistoryOfClusterErrors function checks the error handling in history
+// command.
+func TestHistoryOfClusterErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	testCases := []struct {
+		name     string
+		api      restapi.API
+		args     []string
+		expected string
+	}{
+		{"no cluster", RestAPIMock{}, []string{}, "exactly one cluster needs to be specified"},
+		{"unknown cluster", RestAPIMock{}, []string{"xyzzy"}, "Can not resolve cluster"},
+		{"read error", RestAPIMockErrors{}, []string{"1"}, "Error reading list of clusters"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			captured, err := capture.StandardOutput(func() {
+				err := commands.HistoryOfCluster(tc.api, tc.args)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/hyperlinks.html
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// hyperlinks specifies whether links are displayed as clickable terminal
+// hyperlinks (OSC 8 escape sequence)
+var hyperlinks bool
+
+// SetHyperlinks function enables or disables terminal hyperlinks.
+func SetHyperlinks(enabled bool) {
+	hyperlinks = enabled
+}
+
+// HyperlinksSupported function checks whether standard output is a terminal
+// that is known to support hyperlinks. Detection can be overridden by
+// FORCE_HYPERLINK environment variable.
+func HyperlinksSupported() bool {
+	if force, found := os.LookupEnv("FORCE_HYPERLINK"); found {
+		enabled, err := strconv.ParseBool(force)
+		return err == nil && enabled
+	}
+
+	return stdoutIsTerminal() && terminalSupportsHyperlinks(os.Getenv)
+}
+
+// terminalSupportsHyperlinks function checks environment variables set by
+// terminal emulators that support hyperlinks
+func terminalSupportsHyperlinks(getenv func(string) string) bool {
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+	if getenv("WT_SESSION") != "" || getenv("KONSOLE_VERSION") != "" || getenv("KITTY_WINDOW_ID") != "" {
+		return true
+	}
+	// VTE based terminals (GNOME Terminal, Tilix...) since version 0.50
+	if version, err := strconv.Atoi(getenv("VTE_VERSION")); err == nil && version >= 5000 {
+		return true
+	}
+	switch getenv("TERM") {
+	case "xterm-kitty", "alacritty", "foot":
+		return true
+	}
+	return false
+}
+
+// formatLink function returns link that can be displayed on terminal. When
+// hyperlinks are enabled, the link is clickable. Links with control
+// characters are quoted so they can't change terminal state.
+func formatLink(link string) string {
+	if link == "" {
+		return "none"
+	}
+	if strings.IndexFunc(link, unicode.IsControl) >= 0 {
+		return strconv.Quote(link)
+	}
+	if !hyperlinks {
+		return link
+	}
+	return "\x1b]8;;" + link + "\x1b\\" + link + "\x1b]8;;\x1b\\"
+}
@@ -0,0 +1,262 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/jsondiff.html
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// operations used in structural diff, names are the same as in JSON patch
+// (RFC 6902)
+const (
+	addOperation     = "add"
+	removeOperation  = "remove"
+	replaceOperation = "replace"
+)
+
+// jsonChange represents one change between two JSON documents
+type jsonChange struct {
+	operation string
+	path      []string
+	oldValue  interface{}
+	newValue  interface{}
+}
+
+// jsonPatchOperation represents one operation in JSON patch (RFC 6902)
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// normalizeJSON function parses JSON document. Numbers are kept as
+// json.Number so they are compared and displayed exactly as written.
+func normalizeJSON(content []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var document interface{}
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one document is expected
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON document")
+	}
+	return document, nil
+}
+
+// formatNormalizedJSON function returns indented JSON document with sorted
+// keys in objects
+func formatNormalizedJSON(document interface{}) string {
+	formatted, err := json.MarshalIndent(document, "", "    ")
+	if err != nil {
+		return fmt.Sprint(document)
+	}
+	return string(formatted) + "\n"
+}
+
+// compactJSON function returns compact representation of JSON value
+func compactJSON(value interface{}) string {
+	formatted, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(formatted)
+}
+
+// appendPath function returns new path with one more token, the original
+// path is not changed
+func appendPath(path []string, token string) []string {
+	newPath := make([]string, len(path), len(path)+1)
+	copy(newPath, path)
+	return append(newPath, token)
+}
+
+// structuralDiff function compares two normalized JSON documents and
+// returns list of changes. Objects are compared key by key, arrays are
+// compared item by item.
+func structuralDiff(oldValue, newValue interface{}) []jsonChange {
+	return diffValues([]string{}, oldValue, newValue)
+}
+
+// diffValues function compares two JSON values found on the same path
+func diffValues(path []string, oldValue, newValue interface{}) []jsonChange {
+	switch oldTyped := oldValue.(type) {
+	case map[string]interface{}:
+		if newTyped, ok := newValue.(map[string]interface{}); ok {
+			return diffObjects(path, oldTyped, newTyped)
+		}
+	case []interface{}:
+		if newTyped, ok := newValue.([]interface{}); ok {
+			return diffArrays(path, oldTyped, newTyped)
+		}
+	default:
+		if oldValue == newValue {
+			return nil
+		}
+	}
+
+	// different types or different scalar values
+	return []jsonChange{{replaceOperation, path, oldValue, newValue}}
+}
+
+// diffObjects function compares two JSON objects, keys are processed in
+// alphabetical order
+func diffObjects(path []string, oldObject, newObject map[string]interface{}) []jsonChange {
+	keys := []string{}
+	for key := range oldObject {
+		keys = append(keys, key)
+	}
+	for key := range newObject {
+		if _, found := oldObject[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := []jsonChange{}
+	for _, key := range keys {
+		oldValue, inOld := oldObject[key]
+		newValue, inNew := newObject[key]
+		keyPath := appendPath(path, key)
+		switch {
+		case !inNew:
+			changes = append(changes, jsonChange{removeOperation, keyPath, oldValue, nil})
+		case !inOld:
+			changes = append(changes, jsonChange{addOperation, keyPath, nil, newValue})
+		default:
+			changes = append(changes, diffValues(keyPath, oldValue, newValue)...)
+		}
+	}
+	return changes
+}
+
+// diffArrays function compares two JSON arrays. Removed items are reported
+// from the last one so the changes can be applied in given order.
+func diffArrays(path []string, oldArray, newArray []interface{}) []jsonChange {
+	changes := []jsonChange{}
+
+	common := len(oldArray)
+	if len(newArray) < common {
+		common = len(newArray)
+	}
+	for i := 0; i < common; i++ {
+		changes = append(changes, diffValues(appendPath(path, strconv.Itoa(i)), oldArray[i], newArray[i])...)
+	}
+	for i := len(oldArray) - 1; i >= common; i-- {
+		changes = append(changes, jsonChange{removeOperation, appendPath(path, strconv.Itoa(i)), oldArray[i], nil})
+	}
+	for i := common; i < len(newArray); i++ {
+		changes = append(changes, jsonChange{addOperation, appendPath(path, strconv.Itoa(i)), nil, newArray[i]})
+	}
+	return changes
+}
+
+// jsonPointer function converts path to JSON pointer (RFC 6901)
+func jsonPointer(path []string) string {
+	pointer := ""
+	for _, token := range path {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		pointer += "/" + token
+	}
+	return pointer
+}
+
+// readablePath function converts path to form that is easy to read, for
+// example watch[0].name
+func readablePath(path []string, document interface{}) string {
+	if len(path) == 0 {
+		return "(document)"
+	}
+
+	readable := ""
+	value := document
+	for _, token := range path {
+		switch typed := value.(type) {
+		case []interface{}:
+			readable += "[" + token + "]"
+			index, _ := strconv.Atoi(token)
+			value = nil
+			if index < len(typed) {
+				value = typed[index]
+			}
+		case map[string]interface{}:
+			if readable != "" {
+				readable += "."
+			}
+			readable += token
+			value = typed[token]
+		default:
+			readable += "." + token
+			value = nil
+		}
+	}
+	return readable
+}
+
+// jsonPatch function converts list of changes to JSON patch (RFC 6902)
+func jsonPatch(changes []jsonChange) []jsonPatchOperation {
+	patch := []jsonPatchOperation{}
+	for _, change := range changes {
+		operation := jsonPatchOperation{
+			Op:   change.operation,
+			Path: jsonPointer(change.path),
+		}
+		// null is valid value that needs to be kept in the patch
+		if change.operation != removeOperation {
+			operation.Value = json.RawMessage(compactJSON(change.newValue))
+		}
+		patch = append(patch, operation)
+	}
+	return patch
+}
+
+// printStructuralDiff function displays list of changes. Paths are
+// displayed in readable form, values are displayed as compact JSON.
+func printStructuralDiff(changes []jsonChange, oldDocument, newDocument interface{}) {
+	for _, change := range changes {
+		switch change.operation {
+		case addOperation:
+			path := readablePath(change.path, newDocument)
+			fmt.Println(colorizer.Green("+ " + path + ": " + compactJSON(change.newValue)))
+		case removeOperation:
+			path := readablePath(change.path, oldDocument)
+			fmt.Println(colorizer.Red("- " + path + ": " + compactJSON(change.oldValue)))
+		default:
+			path := readablePath(change.path, oldDocument)
+			fmt.Println(colorizer.Yellow("~ "+path+":"),
+				colorizer.Red(compactJSON(change.oldValue)), "->",
+				colorizer.Green(compactJSON(change.newValue)))
+		}
+	}
+}
@@ -45,4 +45,13 @@ const (
 
 	// cluster UUID
 	clusterUUID = "Cluster"
+
+	// selector used in bulk operation does not match any item
+	nothingSelected = "No items match the selection"
+
+	// edited document is the same as the original one
+	noChanges = "No changes have been made"
+
+	// compared documents are the same
+	noDifferences = "No differences found"
 )
@@ -0,0 +1,136 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/notify.html
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// notifier sends notifications about state changes in background, nil when
+// no endpoint is configured
+var notifier *notifications.Queue
+
+// SetNotifier function sets notifier used by watch and daemon modes to send
+// notifications about state changes of triggers and configurations.
+// Notifications are sent in background, so endpoints that are not available
+// don't block polling of the controller.
+func SetNotifier(configured *notifications.Notifier) {
+	if notifier != nil {
+		notifier.Close()
+		notifier = nil
+	}
+	if configured != nil {
+		notifier = notifications.NewQueue(configured, notifications.DefaultQueueSize)
+	}
+}
+
+// triggersSnapshot function returns snapshot with state of triggers
+func triggersSnapshot(triggers []types.Trigger) notifications.Snapshot {
+	// nil list would mean that the state is not known
+	return notifications.Snapshot{Triggers: append([]types.Trigger{}, triggers...)}
+}
+
+// configurationsSnapshot function returns snapshot with state of cluster
+// configurations
+func configurationsSnapshot(configurations []types.ClusterConfiguration) notifications.Snapshot {
+	// nil list would mean that the state is not known
+	return notifications.Snapshot{Configurations: append([]types.ClusterConfiguration{}, configurations...)}
+}
+
+// readSnapshot function reads state of triggers and configurations needed to
+// detect their state changes
+func readSnapshot(api restapi.API) (notifications.Snapshot, error) {
+	triggers, err := api.ReadListOfTriggers()
+	if err != nil {
+		return notifications.Snapshot{}, err
+	}
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return notifications.Snapshot{}, err
+	}
+	snapshot := triggersSnapshot(triggers)
+	snapshot.Configurations = configurationsSnapshot(configurations).Configurations
+	return snapshot, nil
+}
+
+// notifyStateChanges function detects state changes between two snapshots
+// and queues notifications about them. Number of detected changes is
+// returned together with error describing notifications that failed since
+// the previous call.
+func notifyStateChanges(previous, current notifications.Snapshot) (int, error) {
+	if notifier == nil {
+		return 0, nil
+	}
+	events := notifications.Detect(previous, current, currentTime())
+	if len(events) > 0 {
+		if err := notifier.Add(events); err != nil {
+			return 0, err
+		}
+	}
+	return len(events), notifier.Failures()
+}
+
+// flushNotifications function waits until all queued notifications are sent
+// and displays the failed ones
+func flushNotifications(layout string) {
+	if notifier == nil {
+		return
+	}
+	notifier.Flush()
+	if err := notifier.Failures(); err != nil {
+		fmt.Println(currentTime().Format(layout), colorizer.Red(CannotSendNotificationsErrorMessage+": "+err.Error()))
+	}
+}
+
+// checkStateChanges function reads current state of triggers and
+// configurations and sends notifications about changes since the previous
+// state, which is nil for the first check. The current state is returned.
+func checkStateChanges(api restapi.API, previous *notifications.Snapshot) *notifications.Snapshot {
+	if notifier == nil {
+		return nil
+	}
+	timestamp := currentTime().Format(time.RFC3339)
+
+	current, err := readSnapshot(api)
+	if err != nil {
+		fmt.Println(timestamp, colorizer.Red(CannotSendNotificationsErrorMessage+": "+err.Error()))
+		return previous
+	}
+	if previous == nil {
+		return &current
+	}
+
+	changes, err := notifyStateChanges(*previous, current)
+	if changes > 0 {
+		fmt.Println(timestamp, colorizer.Green("notifying:"), fmt.Sprintf("%d state changes", changes))
+	}
+	if err != nil {
+		fmt.Println(timestamp, colorizer.Red(CannotSendNotificationsErrorMessage+": "+err.Error()))
+	}
+	return &current
+}
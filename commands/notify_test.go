@@ -0,0 +1,181 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking notifications about state changes sent by watch and
+// daemon modes.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/notify_test.html
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// notificationStandIn is a local HTTP server that records received
+// notifications and responds with given status
+type notificationStandIn struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	received []string
+}
+
+// newNotificationStandIn function starts new local webhook endpoint
+func newNotificationStandIn(status int) *notificationStandIn {
+	standIn := &notificationStandIn{}
+	standIn.Server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		standIn.mutex.Lock()
+		defer standIn.mutex.Unlock()
+		standIn.received = append(standIn.received, string(body))
+		writer.WriteHeader(status)
+	}))
+	return standIn
+}
+
+// payloads method returns all received notifications
+func (standIn *notificationStandIn) payloads() string {
+	standIn.mutex.Lock()
+	defer standIn.mutex.Unlock()
+	return strings.Join(standIn.received, "\n")
+}
+
+// useNotifier is a helper function that configures notifier sending
+// notifications to given endpoint in Slack format, the returned function
+// removes the notifier
+func useNotifier(t *testing.T, url string, events ...string) func() {
+	notifier, err := notifications.NewNotifier([]notifications.Endpoint{{
+		URL:    url,
+		Format: notifications.SlackFormat,
+		Events: events,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier.Retries = 0
+	commands.SetNotifier(notifier)
+	return func() {
+		commands.SetNotifier(nil)
+	}
+}
+
+// TestWatchNotifications function checks that state changes of watched
+// triggers are notified.
+func TestWatchNotifications(t *testing.T) {
+	standIn := newNotificationStandIn(http.StatusOK)
+	defer standIn.Close()
+	defer useNotifier(t, standIn.URL, "trigger acknowledged", "trigger created")()
+
+	_, err := runWatch(t, watchMock{calls: new(int)}, []string{"list", "triggers", "--count", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"text":"Trigger 0 (must-gather) for cluster ffffffff-ffff-ffff-ffff-ffffffffffff requested by tester has been acknowledged"}` + "\n" +
+		`{"text":"Trigger 42 (must-gather) for cluster 00000000-0000-0000-0000-000000000000 requested by tester has been created"}`
+	if standIn.payloads() != expected {
+		t.Fatal("Unexpected notifications:\n", standIn.payloads())
+	}
+}
+
+// TestWatchNotificationFailure function checks that failed notifications are
+// recorded in change log.
+func TestWatchNotificationFailure(t *testing.T) {
+	standIn := newNotificationStandIn(http.StatusNotFound)
+	defer standIn.Close()
+	defer useNotifier(t, standIn.URL)()
+
+	captured, err := runWatch(t, watchMock{calls: new(int)}, []string{"list", "triggers", "--count", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "10:00:05 Can not send notifications: trigger 0 for cluster") ||
+		!strings.Contains(captured, "404 Not Found") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// configurationsMock is an implementation of mocked REST API where
+// configuration 1 is disabled after the first call
+type configurationsMock struct {
+	RestAPIMock
+	calls *int
+}
+
+// ReadListOfConfigurations returns mocked configurations
+func (api configurationsMock) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	*api.calls++
+	list, err := api.RestAPIMock.ReadListOfConfigurations()
+	if *api.calls > 1 {
+		list[1].Active = "0"
+	}
+	return list, err
+}
+
+// TestCheckStateChanges function checks detection of state changes done by
+// daemon.
+func TestCheckStateChanges(t *testing.T) {
+	configureColorizer()
+	defer commands.SetCurrentTime(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC))()
+	standIn := newNotificationStandIn(http.StatusOK)
+	defer standIn.Close()
+	defer useNotifier(t, standIn.URL, "configuration disabled")()
+
+	api := configurationsMock{RestAPIMock{}, new(int)}
+
+	// the first check just reads the state
+	snapshot := commands.CheckStateChanges(api, nil)
+	if snapshot == nil || standIn.payloads() != "" {
+		t.Fatal("State should be read without notifications")
+	}
+
+	captured, err := capture.StandardOutput(func() {
+		snapshot = commands.CheckStateChanges(api, snapshot)
+		commands.FlushNotifications(time.RFC3339)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if captured != "2020-01-01T10:00:00Z notifying: 1 state changes\n" {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if !strings.Contains(standIn.payloads(), "Configuration 1 for cluster 00000000-0000-0000-0000-000000000000 has been disabled") {
+		t.Fatal("Unexpected notifications:\n", standIn.payloads())
+	}
+}
+
+// TestCheckStateChangesWithoutNotifier function checks that state is not read
+// when notifications are not configured.
+func TestCheckStateChangesWithoutNotifier(t *testing.T) {
+	api := configurationsMock{RestAPIMock{}, new(int)}
+	if commands.CheckStateChanges(api, nil) != nil || *api.calls != 0 {
+		t.Fatal("State should not be read")
+	}
+}
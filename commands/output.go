@@ -0,0 +1,75 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/output.html
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// supported output formats
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+	yamlOutput = "yaml"
+)
+
+// addOutputFlag function registers flag used to select output format
+func addOutputFlag(flags *flag.FlagSet) *string {
+	return flags.String("output", textOutput, "output format: text, json or yaml")
+}
+
+// checkOutputFormat function checks if the output format is supported
+func checkOutputFormat(format string) error {
+	switch format {
+	case textOutput, jsonOutput, yamlOutput:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// printMachineReadable function displays the value in JSON or YAML format
+func printMachineReadable(format string, value interface{}) error {
+	var output []byte
+	var err error
+
+	switch format {
+	case jsonOutput:
+		output, err = json.MarshalIndent(value, "", "    ")
+		output = append(output, '\n')
+	case yamlOutput:
+		output, err = yaml.Marshal(value)
+	default:
+		err = fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(output))
+	return nil
+}
@@ -23,8 +23,10 @@ package commands
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/profiles.html
 
 import (
+	"errors"
 	"fmt"
 	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 	"github.com/c-bata/go-prompt"
 	"os"
 )
@@ -43,15 +45,29 @@ func ListOfProfiles(api restapi.API) {
 	}
 
 	// REST API call returns data
-	fmt.Println(colorizer.Magenta("List of configuration profiles"))
-	fmt.Printf("%4s %4s %-20s %-20s %s\n", "#", "ID", changedAt, changedBy, "Description")
+	profilesTable(profiles).print()
+}
+
+// profilesTable function prepares table with list of configuration profiles
+func profilesTable(profiles []types.ConfigurationProfile) table {
+	result := table{
+		Kind:    "profile",
+		Title:   "List of configuration profiles",
+		Header:  fmt.Sprintf("%4s %4s %-20s %-20s %s", "#", "ID", changedAt, changedBy, "Description"),
+		Columns: []string{changedAt, changedBy, "Description"},
+	}
 
 	// list all profiles
 	for i, profile := range profiles {
 		// update timestamps not to contain irrelevant parts
-		changedAt := profile.ChangedAt[0:19]
-		fmt.Printf("%4d %4d %-20s %-20s %-s\n", i, profile.ID, changedAt, profile.ChangedBy, profile.Description)
+		changedAt := displayedTimestamp(profile.ChangedAt)
+		result.Rows = append(result.Rows, tableRow{
+			ID:     profile.ID,
+			Fields: []string{changedAt, profile.ChangedBy, profile.Description},
+			Line:   fmt.Sprintf("%4d %4d %-20s %-20s %-s", i, profile.ID, changedAt, profile.ChangedBy, profile.Description),
+		})
 	}
+	return result
 }
 
 // DescribeProfile function displays additional information about selected
@@ -176,3 +192,65 @@ func AddConfigurationProfileImpl(api restapi.API, username, description, configu
 	// everything's ok, configuration profile has been created
 	fmt.Println(colorizer.Blue("Configuration profile has been created"))
 }
+
+// EditProfile function opens the configuration profile selected by its ID in
+// text editor and uploads the edited version of profile. Description of
+// profile is kept unless a new one is specified by --description flag.
+func EditProfile(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("edit profile")
+	description := flags.String("description", "", "new description of configuration profile")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		err = fmt.Errorf("exactly one configuration profile needs to be specified")
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	profileID := positional[0]
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	// try to read configuration profile identified by its ID and display
+	// error when something wrong happens
+	profile, err := api.ReadConfigurationProfile(profileID)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingConfigurationProfile))
+		fmt.Println(err)
+		return err
+	}
+	if *description == "" {
+		*description = profile.Description
+	}
+
+	configuration, err := editJSONDocument([]byte(profile.Configuration), "profile-*.json")
+	if err != nil || configuration == nil {
+		return err
+	}
+
+	if askForConfirmation {
+		if !ProceedQuestion("Configuration profile " + profileID + " will be updated") {
+			return nil
+		}
+	}
+
+	// try to update configuration profile and display error when
+	// something wrong happens
+	err = api.UpdateConfigurationProfile(profileID, username, *description, configuration)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, configuration profile has been updated
+	fmt.Println(colorizer.Blue("Configuration profile " + profileID + " has been updated"))
+	return nil
+}
@@ -23,6 +23,7 @@ package commands_test
 
 import (
 	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
 	"github.com/tisnik/go-capture"
 	"strings"
 	"testing"
@@ -372,3 +373,183 @@ func TestDeleteConfigurationProfileNoConfirmErrorHandling(t *testing.T) {
 		t.Fatal("Unexpected output:\n", captured)
 	}
 }
+
+// setEditor is a helper function that configures text editor used by edit
+// commands. Shell script is used instead of real editor, the edited file
+// is passed to the script as its first parameter.
+func setEditor(t *testing.T, script string) {
+	t.Setenv("EDITOR", "sh -c '"+script+"' sh")
+}
+
+// TestEditProfile function checks whether the edited configuration profile
+// is uploaded and the diff is displayed.
+func TestEditProfile(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor replaces the whole profile
+	setEditor(t, `printf "{\"no_op\": \"X\"}" > "$1"`)
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditProfile(RestAPIMock{}, "tester", []string{"0"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	expected := []string{
+		"- *configuration*",
+		`+ {"no_op": "X"}`,
+		"Configuration profile 0 has been updated",
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestEditProfileNoChanges function checks whether nothing is uploaded when
+// the profile has not been changed in editor.
+func TestEditProfileNoChanges(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor that does not change the file
+	setEditor(t, "true")
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditProfile(RestAPIMock{}, "tester", []string{"0"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	if !strings.HasPrefix(captured, "No changes have been made") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestEditProfileInvalidJSON function checks whether invalid configuration
+// profile is refused.
+func TestEditProfileInvalidJSON(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor that makes the document invalid
+	setEditor(t, `printf "{" > "$1"`)
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditProfile(RestAPIMock{}, "tester", []string{"0"}, false)
+		if err == nil {
+			t.Fatal("Error is expected for invalid JSON")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	if !strings.HasPrefix(captured, "Edited document is not valid JSON") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestEditProfileEditorFailure function checks the behaviour when the text
+// editor can not be started or fails.
+func TestEditProfileEditorFailure(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor that always fails
+	setEditor(t, "exit 1")
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		err := commands.EditProfile(RestAPIMock{}, "tester", []string{"0"}, false)
+		if err == nil {
+			t.Fatal("Error is expected when editor fails")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// test the captured output
+	if !strings.HasPrefix(captured, "Can not run text editor") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestEditProfileErrors function checks the error handling in the edit
+// profile command.
+func TestEditProfileErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// editor replaces the whole profile
+	setEditor(t, `printf "{}" > "$1"`)
+
+	testCases := []struct {
+		name     string
+		api      restapi.API
+		username string
+		args     []string
+		expected string
+	}{
+		{"no profile", RestAPIMock{}, "tester", []string{}, "Invalid command arguments"},
+		{"improper flag", RestAPIMock{}, "tester", []string{"0", "--foo"}, "Invalid command arguments"},
+		{"not logged in", RestAPIMock{}, "", []string{"0"}, "Not logged in"},
+		{"read error", RestAPIMockErrors{}, "tester", []string{"0"}, "Error reading configuration profile"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// use go-capture package to capture all writes to standard output
+			captured, err := capture.StandardOutput(func() {
+				err := commands.EditProfile(tc.api, tc.username, tc.args, false)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			// test the captured output
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+		})
+	}
+}
+
+// TestListOfProfilesShortTimestamps checks that the command 'list profiles'
+// displays timestamps that can't be parsed as they are
+func TestListOfProfilesShortTimestamps(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		commands.ListOfProfiles(shortTimestampsMock{})
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "2020-01-01") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/prune.html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// parseAge function parses age of triggers. Besides durations accepted by
+// time.ParseDuration, number of days can be specified, for example "7d" or
+// "1d12h".
+func parseAge(value string) (time.Duration, error) {
+	var age time.Duration
+	rest := value
+	if days, hours, found := strings.Cut(value, "d"); found {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age: %q", value)
+		}
+		age = time.Duration(count) * 24 * time.Hour
+		rest = hours
+	}
+	if rest != "" {
+		duration, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age: %q", value)
+		}
+		age += duration
+	}
+	if age <= 0 {
+		return 0, fmt.Errorf("age needs to be positive: %q", value)
+	}
+	return age, nil
+}
+
+// staleTriggers function returns triggers that have not been acknowledged
+// and that have been created before given time. Only active triggers are
+// returned when they are to be deactivated.
+func staleTriggers(list []types.Trigger, cluster string, before time.Time, activeOnly bool) []types.Trigger {
+	stale := []types.Trigger{}
+	for _, trigger := range list {
+		if cluster != "" && trigger.Cluster != cluster {
+			continue
+		}
+		if triggerAcked(trigger) || (activeOnly && trigger.Active != 1) {
+			continue
+		}
+		triggeredAt, err := parseTimestamp(trigger.TriggeredAt)
+		if err != nil || !triggeredAt.Before(before) {
+			continue
+		}
+		stale = append(stale, trigger)
+	}
+	return stale
+}
+
+// PruneTriggers function deactivates or deletes stale triggers, i.e.
+// triggers that have not been acknowledged and that are older than age
+// specified by --older-than flag. Triggers are deactivated by default, they
+// are deleted when --delete flag is used.
+func PruneTriggers(api restapi.API, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("prune triggers")
+	olderThan := flags.String("older-than", "", "minimal age of stale triggers, for example 7d or 12h")
+	deactivate := flags.Bool("deactivate", false, "deactivate stale triggers (default)")
+	remove := flags.Bool("delete", false, "delete stale triggers")
+	cluster := flags.String(clusterFlag, "", "prune triggers for given cluster only")
+	options := addBulkFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 0:
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional, " "))
+	case *olderThan == "":
+		return reportInvalidArguments("age of stale triggers needs to be specified by --older-than flag")
+	case *deactivate && *remove:
+		return reportInvalidArguments("--deactivate and --delete flags can not be combined")
+	}
+
+	age, err := parseAge(*olderThan)
+	if err != nil {
+		return reportInvalidArguments(err.Error())
+	}
+
+	clusterName, err := resolveClusterName(api, *cluster)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotResolveClusterErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	list, err := api.ReadListOfTriggers()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfTriggers))
+		fmt.Println(err)
+		return err
+	}
+
+	stale := staleTriggers(list, clusterName, currentTime().UTC().Add(-age), !*remove)
+	if len(stale) == 0 {
+		fmt.Println(colorizer.Blue("No stale triggers found"))
+		return nil
+	}
+
+	staleTable := triggersTable(stale)
+	staleTable.Title = "Triggers not acknowledged for " + formatDuration(age)
+	staleTable.print()
+
+	operation, action, done := api.DeactivateTrigger, "deactivated", colorizer.Green("deactivated")
+	if *remove {
+		operation, action, done = api.DeleteTrigger, deleted, colorizer.Red(deleted)
+	}
+	if askForConfirmation && !ProceedQuestion(fmt.Sprintf("%d stale triggers will be %s", len(stale), action)) {
+		return nil
+	}
+
+	ids := make([]string, len(stale))
+	for i, trigger := range stale {
+		ids[i] = strconv.Itoa(trigger.ID)
+	}
+	results := runBulkOperation(ids, *options, operation)
+	return reportBulkResults(results, triggerMessage, done)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking pruning of triggers that have not been acknowledged.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/prune_test.html
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// pruneMock is an implementation of mocked REST API with triggers of
+// different age that records deactivated and deleted triggers
+type pruneMock struct {
+	RestAPIMock
+	calls *[]string
+}
+
+// ReadListOfTriggers returns triggers of different age, the current time is
+// 2020-01-10
+func (api pruneMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	const notAcked = "1970-01-01T00:00:00"
+	return []types.Trigger{
+		{ID: 1, Type: "must-gather", Cluster: "cluster-a", TriggeredAt: "2020-01-01T00:00:00", AckedAt: notAcked, Active: 1},
+		{ID: 2, Type: "must-gather", Cluster: "cluster-b", TriggeredAt: "2020-01-02T00:00:00", AckedAt: notAcked, Active: 1},
+		{ID: 3, Type: "must-gather", Cluster: "cluster-a", TriggeredAt: "2020-01-01T00:00:00", AckedAt: "2020-01-01T00:05:00", Active: 1},
+		{ID: 4, Type: "must-gather", Cluster: "cluster-a", TriggeredAt: "2020-01-01T00:00:00", AckedAt: notAcked, Active: 0},
+		{ID: 5, Type: "must-gather", Cluster: "cluster-a", TriggeredAt: "2020-01-09T00:00:00", AckedAt: notAcked, Active: 1},
+	}, nil
+}
+
+// ReadListOfClusters returns clusters used by triggers
+func (api pruneMock) ReadListOfClusters() ([]types.Cluster, error) {
+	return []types.Cluster{{ID: 1, Name: "cluster-a"}, {ID: 2, Name: "cluster-b"}}, nil
+}
+
+// DeactivateTrigger records deactivated trigger
+func (api pruneMock) DeactivateTrigger(triggerID string) error {
+	*api.calls = append(*api.calls, "deactivate "+triggerID)
+	return nil
+}
+
+// DeleteTrigger records deleted trigger
+func (api pruneMock) DeleteTrigger(triggerID string) error {
+	*api.calls = append(*api.calls, "delete "+triggerID)
+	return nil
+}
+
+// runPruneTriggers is a helper function that runs prune triggers command
+// and returns its output, recorded calls and error
+func runPruneTriggers(t *testing.T, args ...string) (string, []string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.SetCurrentTime(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC))()
+
+	api := pruneMock{calls: &[]string{}}
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.PruneTriggers(api, append(args, "--workers", "1"), false)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	calls := *api.calls
+	sort.Strings(calls)
+	return captured, calls, commandErr
+}
+
+// TestPruneTriggersDeactivate function checks that active triggers that have
+// not been acknowledged are deactivated by default.
+func TestPruneTriggersDeactivate(t *testing.T) {
+	captured, calls, err := runPruneTriggers(t, "--older-than", "7d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(calls, ",") != "deactivate 1,deactivate 2" {
+		t.Fatal("Unexpected calls:", calls)
+	}
+	if !strings.Contains(captured, "Triggers not acknowledged for 7d") ||
+		!strings.Contains(captured, "Trigger 1 has been deactivated") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestPruneTriggersDelete function checks that triggers that have not been
+// acknowledged are deleted including inactive ones.
+func TestPruneTriggersDelete(t *testing.T) {
+	_, calls, err := runPruneTriggers(t, "--older-than", "8d12h", "--delete")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(calls, ",") != "delete 1,delete 4" {
+		t.Fatal("Unexpected calls:", calls)
+	}
+}
+
+// TestPruneTriggersCluster function checks that triggers can be pruned for
+// one cluster only.
+func TestPruneTriggersCluster(t *testing.T) {
+	_, calls, err := runPruneTriggers(t, "--older-than", "12h", "--cluster", "cluster-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(calls, ",") != "deactivate 2" {
+		t.Fatal("Unexpected calls:", calls)
+	}
+}
+
+// TestPruneTriggersNothingToPrune function checks the output when there's
+// no stale trigger.
+func TestPruneTriggersNothingToPrune(t *testing.T) {
+	captured, calls, err := runPruneTriggers(t, "--older-than", "30d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 0 || captured != "No stale triggers found\n" {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestPruneTriggersInvalidArguments function checks that invalid arguments
+// are refused.
+func TestPruneTriggersInvalidArguments(t *testing.T) {
+	invalid := [][]string{
+		{},
+		{"--older-than", "week"},
+		{"--older-than", "-1d"},
+		{"--older-than", "7d", "--delete", "--deactivate"},
+		{"--older-than", "7d", "1"},
+	}
+	for _, args := range invalid {
+		captured, calls, err := runPruneTriggers(t, args...)
+		if err == nil {
+			t.Fatal("Error is expected to be returned for", args)
+		}
+		if !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+		if len(calls) != 0 {
+			t.Fatal("No trigger should be pruned:", calls)
+		}
+	}
+}
+
+// TestParseAge function checks parsing of trigger age.
+func TestParseAge(t *testing.T) {
+	expected := map[string]time.Duration{
+		"7d":    7 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"90m":   90 * time.Minute,
+	}
+	for value, age := range expected {
+		parsed, err := commands.ParseAge(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != age {
+			t.Fatal("Unexpected age for", value, parsed)
+		}
+	}
+	for _, value := range []string{"", "d", "0d", "1x", "1d1x"} {
+		if _, err := commands.ParseAge(value); err == nil {
+			t.Fatal("Error is expected for", value)
+		}
+	}
+}
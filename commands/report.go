@@ -0,0 +1,263 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/report.html
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// defaultAckThreshold is the time in which triggers are expected to be
+// acknowledged
+const defaultAckThreshold = time.Hour
+
+// ackStatistics represents statistics of time to ack computed for triggers
+// of one cluster or one requester
+type ackStatistics struct {
+	name    string
+	acked   []time.Duration
+	pending int
+}
+
+// percentile function returns value of given percentile (nearest-rank
+// method) from sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// timeToAck function returns time between trigger creation and its
+// acknowledgement, for active triggers that have not been acknowledged yet
+// the time they are pending is returned. The last value is false when the
+// time can't be computed, i.e. for deactivated triggers that have never been
+// acknowledged.
+func timeToAck(trigger types.Trigger, now time.Time) (time.Duration, bool, bool) {
+	triggeredAt, err := parseTimestamp(trigger.TriggeredAt)
+	if err != nil {
+		return 0, false, false
+	}
+	if triggerAcked(trigger) {
+		ackedAt, err := parseTimestamp(trigger.AckedAt)
+		if err != nil {
+			return 0, false, false
+		}
+		return ackedAt.Sub(triggeredAt), false, true
+	}
+	if trigger.Active != 1 {
+		return 0, false, false
+	}
+	return now.Sub(triggeredAt), true, true
+}
+
+// triggerReport contains statistics of time to ack and triggers that
+// breached the threshold
+type triggerReport struct {
+	clusters       []*ackStatistics
+	requesters     []*ackStatistics
+	breaches       []types.Trigger
+	activeBreaches int
+}
+
+// sortedStatistics function returns statistics sorted by their names, the
+// durations are sorted as well
+func sortedStatistics(statistics map[string]*ackStatistics) []*ackStatistics {
+	result := make([]*ackStatistics, 0, len(statistics))
+	for _, item := range statistics {
+		sort.Slice(item.acked, func(i, j int) bool { return item.acked[i] < item.acked[j] })
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// computeTriggerReport function computes statistics of time to ack for
+// selected triggers and finds triggers that breached the threshold
+func computeTriggerReport(list []types.Trigger, now time.Time, threshold time.Duration) triggerReport {
+	var report triggerReport
+	clusters := map[string]*ackStatistics{}
+	requesters := map[string]*ackStatistics{}
+
+	record := func(statistics map[string]*ackStatistics, name string, duration time.Duration, pending bool) {
+		item, found := statistics[name]
+		if !found {
+			item = &ackStatistics{name: name}
+			statistics[name] = item
+		}
+		if pending {
+			item.pending++
+		} else {
+			item.acked = append(item.acked, duration)
+		}
+	}
+
+	for _, trigger := range list {
+		duration, pending, ok := timeToAck(trigger, now)
+		if !ok {
+			continue
+		}
+		record(clusters, trigger.Cluster, duration, pending)
+		record(requesters, trigger.TriggeredBy, duration, pending)
+		if duration > threshold {
+			report.breaches = append(report.breaches, trigger)
+			if pending {
+				report.activeBreaches++
+			}
+		}
+	}
+
+	report.clusters = sortedStatistics(clusters)
+	report.requesters = sortedStatistics(requesters)
+	sort.Slice(report.breaches, func(i, j int) bool {
+		return report.breaches[i].TriggeredAt < report.breaches[j].TriggeredAt
+	})
+	return report
+}
+
+// printAckStatistics function displays table with statistics of time to ack
+func printAckStatistics(title, column string, statistics []*ackStatistics) {
+	fmt.Println(colorizer.Magenta(title))
+	fmt.Printf("%-36s %6s %8s %12s %12s %12s\n", column, "Acked", "Pending", "Median", "P95", "Max")
+	for _, item := range statistics {
+		median, p95, max := "-", "-", "-"
+		if len(item.acked) > 0 {
+			median = formatDuration(percentile(item.acked, 50))
+			p95 = formatDuration(percentile(item.acked, 95))
+			max = formatDuration(item.acked[len(item.acked)-1])
+		}
+		fmt.Printf("%-36s %6d %8d %12s %12s %12s\n", item.name, len(item.acked), item.pending, median, p95, max)
+	}
+}
+
+// printBreaches function displays triggers that breached the threshold
+func printBreaches(breaches []types.Trigger, threshold time.Duration) {
+	fmt.Println(colorizer.Magenta("Triggers not acknowledged within " + formatDuration(threshold)))
+	if len(breaches) == 0 {
+		fmt.Println(colorizer.Green("none"))
+		return
+	}
+	fmt.Printf("%4s %-16s %-36s %-20s %-20s %s\n", "ID", "Type", clusterUUID, "Triggered by", "Triggered at", "Time to ack")
+	for _, trigger := range breaches {
+		line := fmt.Sprintf("%4d %-16s %-36s %-20s %-20s %s", trigger.ID, trigger.Type, trigger.Cluster,
+			trigger.TriggeredBy, trigger.TriggeredAt, formatTimeToAck(trigger))
+		if triggerAcked(trigger) {
+			fmt.Println(line)
+		} else {
+			fmt.Println(colorizer.Red(line))
+		}
+	}
+}
+
+// ReportTriggers function displays statistics of time to ack (median, 95th
+// percentile and maximum) per cluster and per requester and lists triggers
+// that have not been acknowledged within threshold specified by --threshold
+// flag. Triggers can be filtered by --type, --cluster and --since flags.
+// StatusError with ExitStatusBreach is returned when any active trigger
+// breached the threshold.
+func ReportTriggers(api restapi.API, args []string) error {
+	flags := newFlagSet("report triggers")
+	thresholdValue := flags.String("threshold", formatDuration(defaultAckThreshold), "time in which triggers are expected to be acknowledged")
+	triggerType := flags.String("type", "", "report triggers of given type only")
+	cluster := flags.String(clusterFlag, "", "report triggers for given cluster only")
+	sinceValue := flags.String("since", "", "report triggers created in given time only, for example 30d")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional, " "))
+	}
+
+	threshold, err := parseAge(*thresholdValue)
+	if err != nil {
+		return reportInvalidArguments(err.Error())
+	}
+	var since time.Duration
+	if *sinceValue != "" {
+		since, err = parseAge(*sinceValue)
+		if err != nil {
+			return reportInvalidArguments(err.Error())
+		}
+	}
+
+	clusterName, err := resolveClusterName(api, *cluster)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotResolveClusterErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	list, err := api.ReadListOfTriggers()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfTriggers))
+		fmt.Println(err)
+		return err
+	}
+
+	// timestamps returned by the controller service are in UTC
+	now := currentTime().UTC()
+	selected := []types.Trigger{}
+	for _, trigger := range list {
+		if *triggerType != "" && trigger.Type != *triggerType {
+			continue
+		}
+		if clusterName != "" && trigger.Cluster != clusterName {
+			continue
+		}
+		if since != 0 {
+			triggeredAt, err := parseTimestamp(trigger.TriggeredAt)
+			if err != nil || triggeredAt.Before(now.Add(-since)) {
+				continue
+			}
+		}
+		selected = append(selected, trigger)
+	}
+
+	report := computeTriggerReport(selected, now, threshold)
+	printAckStatistics("Time to ack per cluster", clusterUUID, report.clusters)
+	fmt.Println()
+	printAckStatistics("Time to ack per requester", "Triggered by", report.requesters)
+	fmt.Println()
+	printBreaches(report.breaches, threshold)
+
+	if report.activeBreaches == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("%d active triggers have not been acknowledged within %s", report.activeBreaches, formatDuration(threshold))
+	fmt.Println()
+	fmt.Println(colorizer.Red(message))
+	return &StatusError{Status: ExitStatusBreach, Err: errors.New(message)}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking report of time to ack.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/report_test.html
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// reportMock is an implementation of mocked REST API with triggers
+// acknowledged after different time, the current time is 2020-01-10 12:00
+type reportMock struct {
+	RestAPIMock
+	pending bool
+}
+
+// ReadListOfTriggers returns triggers acknowledged after 10m, 20m, 30m, 2h and
+// optionally one pending trigger created 3 hours ago
+func (api reportMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	const notAcked = "1970-01-01T00:00:00"
+	list := []types.Trigger{
+		{ID: 1, Type: "must-gather", Cluster: "cluster-a", TriggeredBy: "alice", TriggeredAt: "2020-01-01T00:00:00", AckedAt: "2020-01-01T00:10:00", Active: 0},
+		{ID: 2, Type: "must-gather", Cluster: "cluster-a", TriggeredBy: "bob", TriggeredAt: "2020-01-02T00:00:00", AckedAt: "2020-01-02T00:20:00", Active: 0},
+		{ID: 3, Type: "must-gather", Cluster: "cluster-a", TriggeredBy: "alice", TriggeredAt: "2020-01-03T00:00:00", AckedAt: "2020-01-03T00:30:00", Active: 0},
+		{ID: 4, Type: "must-gather", Cluster: "cluster-b", TriggeredBy: "alice", TriggeredAt: "2020-01-04T00:00:00", AckedAt: "2020-01-04T02:00:00", Active: 0},
+		{ID: 5, Type: "custom-gather", Cluster: "cluster-b", TriggeredBy: "bob", TriggeredAt: "2020-01-05T00:00:00", AckedAt: notAcked, Active: 0},
+	}
+	if api.pending {
+		list = append(list, types.Trigger{ID: 6, Type: "must-gather", Cluster: "cluster-b", TriggeredBy: "bob",
+			TriggeredAt: "2020-01-10T09:00:00", AckedAt: notAcked, Active: 1})
+	}
+	return list, nil
+}
+
+// ReadListOfClusters returns clusters used by triggers
+func (api reportMock) ReadListOfClusters() ([]types.Cluster, error) {
+	return []types.Cluster{{ID: 1, Name: "cluster-a"}, {ID: 2, Name: "cluster-b"}}, nil
+}
+
+// runReportTriggers is a helper function that runs report triggers command
+// and returns its output and error
+func runReportTriggers(t *testing.T, api reportMock, args ...string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.SetCurrentTime(time.Date(2020, 1, 10, 12, 0, 0, 0, time.UTC))()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.ReportTriggers(api, args)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// findLine is a helper function that returns fields of the first line that
+// starts with given prefix
+func findLine(t *testing.T, captured, prefix string) []string {
+	for _, line := range strings.Split(captured, "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return strings.Fields(line)
+		}
+	}
+	t.Fatal("Line not found:", prefix, "\n", captured)
+	return nil
+}
+
+// TestReportTriggersStatistics function checks statistics computed per
+// cluster and per requester.
+func TestReportTriggersStatistics(t *testing.T) {
+	captured, err := runReportTriggers(t, reportMock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"cluster-a": "cluster-a 3 0 20m0s 30m0s 30m0s",
+		"cluster-b": "cluster-b 1 0 2h0m0s 2h0m0s 2h0m0s",
+		"alice":     "alice 3 0 30m0s 2h0m0s 2h0m0s",
+		"bob":       "bob 1 0 20m0s 20m0s 20m0s",
+	}
+	for prefix, line := range expected {
+		if fields := findLine(t, captured, prefix); strings.Join(fields, " ") != line {
+			t.Fatal("Unexpected statistics:", fields)
+		}
+	}
+
+	// trigger acknowledged after 2 hours breached the threshold
+	if fields := findLine(t, captured, "   4 "); fields[len(fields)-1] != "2h0m0s" {
+		t.Fatal("Unexpected breach:", fields)
+	}
+	if strings.Contains(captured, "   5 ") {
+		t.Fatal("Deactivated trigger should not be reported:\n", captured)
+	}
+}
+
+// TestReportTriggersActiveBreach function checks that pending trigger that
+// breached the threshold is reported by exit status.
+func TestReportTriggersActiveBreach(t *testing.T) {
+	captured, err := runReportTriggers(t, reportMock{pending: true})
+	if commands.ExitStatus(err) != commands.ExitStatusBreach {
+		t.Fatal("Unexpected error:", err)
+	}
+	if fields := findLine(t, captured, "bob"); strings.Join(fields, " ") != "bob 1 1 20m0s 20m0s 20m0s" {
+		t.Fatal("Unexpected statistics:", fields)
+	}
+	if !strings.Contains(captured, "not acked yet, pending for 3h0m0s") ||
+		!strings.HasSuffix(captured, "1 active triggers have not been acknowledged within 1h0m0s\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+
+	// pending trigger is in time with higher threshold
+	_, err = runReportTriggers(t, reportMock{pending: true}, "--threshold", "4h")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReportTriggersFilters function checks filtering of triggers.
+func TestReportTriggersFilters(t *testing.T) {
+	captured, err := runReportTriggers(t, reportMock{pending: true}, "--cluster", "cluster-a", "--since", "8d12h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(findLine(t, captured, "cluster-a"), " ") != "cluster-a 2 0 20m0s 30m0s 30m0s" ||
+		strings.Contains(captured, "cluster-b") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+
+	captured, err = runReportTriggers(t, reportMock{}, "--type", "custom-gather")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(captured, "cluster-") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestReportTriggersInvalidArguments function checks that invalid arguments
+// are refused.
+func TestReportTriggersInvalidArguments(t *testing.T) {
+	for _, args := range [][]string{{"--threshold", "soon"}, {"--since", "-1d"}, {"unexpected"}} {
+		captured, err := runReportTriggers(t, reportMock{}, args...)
+		if err == nil || !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/resolver.html
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// errCannotReadClusters is returned by resolver when list of clusters can't
+// be read from the controller service
+var errCannotReadClusters = errors.New(ErrorReadingListOfClusters)
+
+// parseClusterID function checks if cluster is specified by its numeric ID.
+// Numbers with leading zeros or sign are not considered to be IDs, because
+// names of clusters (UUIDs) often start with zeros and such numbers are
+// prefixes of names.
+func parseClusterID(cluster string) (int, bool) {
+	id, err := strconv.Atoi(cluster)
+	if err != nil || strconv.Itoa(id) != cluster {
+		return 0, false
+	}
+	return id, true
+}
+
+// findCluster function tries to find cluster in the list of clusters. Cluster
+// can be specified by its name (UUID), by its numeric ID, or by unambiguous
+// prefix of its name. Exact name has the highest priority; when the numeric ID
+// and name prefix match different clusters, the specification is ambiguous.
+func findCluster(clusters []types.Cluster, cluster string) (*types.Cluster, error) {
+	cluster = strings.TrimSpace(cluster)
+	if cluster == "" {
+		return nil, errors.New("cluster is not specified")
+	}
+
+	// exact name
+	for i := range clusters {
+		if clusters[i].Name == cluster {
+			return &clusters[i], nil
+		}
+	}
+
+	// numeric ID and name prefix
+	id, isID := parseClusterID(cluster)
+	candidates := []*types.Cluster{}
+	for i := range clusters {
+		if (isID && clusters[i].ID == id) || strings.HasPrefix(clusters[i].Name, cluster) {
+			candidates = append(candidates, &clusters[i])
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("cluster %s not found", cluster)
+	case 1:
+		return candidates[0], nil
+	default:
+		matches := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			matches[i] = fmt.Sprintf("%d (%s)", candidate.ID, candidate.Name)
+		}
+		return nil, fmt.Errorf("cluster %s is ambiguous, it matches: %s",
+			cluster, strings.Join(matches, ", "))
+	}
+}
+
+// ResolveCluster function reads list of clusters via REST API and tries to
+// find the cluster specified by its name, numeric ID, or unambiguous prefix of
+// its name.
+func ResolveCluster(api restapi.API, cluster string) (*types.Cluster, error) {
+	clusters, err := api.ReadListOfClusters()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCannotReadClusters, err)
+	}
+	return findCluster(clusters, cluster)
+}
+
+// resolveClusterOrReport function resolves the cluster and displays error
+// message when the cluster can't be resolved.
+func resolveClusterOrReport(api restapi.API, cluster string) (*types.Cluster, bool) {
+	resolved, err := ResolveCluster(api, cluster)
+	if err != nil {
+		if errors.Is(err, errCannotReadClusters) {
+			fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		} else {
+			fmt.Println(colorizer.Red(CannotResolveClusterErrorMessage))
+		}
+		fmt.Println(err)
+		return nil, false
+	}
+	return resolved, true
+}
+
+// resolveClusterName function returns name of cluster specified by its name,
+// numeric ID, or unambiguous prefix of its name. Empty string is returned as
+// is, because it is used as "no cluster" by selectors.
+func resolveClusterName(api restapi.API, cluster string) (string, error) {
+	if cluster == "" {
+		return "", nil
+	}
+	resolved, err := ResolveCluster(api, cluster)
+	if err != nil {
+		return "", err
+	}
+	return resolved.Name, nil
+}
+
+// exactClusterName function checks that cluster with exactly the given name
+// exists. Numeric IDs and prefixes are not accepted, because the name is not
+// entered by user who could check what cluster has been selected.
+func exactClusterName(api restapi.API, cluster string) (string, error) {
+	clusters, err := api.ReadListOfClusters()
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errCannotReadClusters, err)
+	}
+	for _, candidate := range clusters {
+		if candidate.Name == cluster {
+			return candidate.Name, nil
+		}
+	}
+	return "", fmt.Errorf("cluster %s not found", cluster)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking resolution of clusters specified by name, ID, or prefix.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/resolver_test.html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// idPrefixClashMock is mocked REST API with cluster that has ID 12 and
+// another cluster with name starting with "12"
+type idPrefixClashMock struct {
+	RestAPIMock
+}
+
+// ReadListOfClusters returns clusters whose ID and name prefix clash
+func (api idPrefixClashMock) ReadListOfClusters() ([]types.Cluster, error) {
+	return []types.Cluster{
+		{ID: 12, Name: "c8590f31-e97e-4b85-b506-c45ce1911a12"},
+		{ID: 13, Name: "12345678-0000-0000-0000-000000000000"},
+	}, nil
+}
+
+// TestResolveCluster checks that cluster can be specified by its name,
+// numeric ID, or prefix of its name
+func TestResolveCluster(t *testing.T) {
+	restAPIMock := RestAPIMock{}
+
+	expected := map[string]string{
+		"c8590f31-e97e-4b85-b506-c45ce1911a12": "c8590f31-e97e-4b85-b506-c45ce1911a12",
+		"1":                                    "00000000-0000-0000-0000-000000000000",
+		"ffff":                                 "ffffffff-ffff-ffff-ffff-ffffffffffff",
+		" c85":                                 "c8590f31-e97e-4b85-b506-c45ce1911a12",
+	}
+
+	for cluster, name := range expected {
+		resolved, err := commands.ResolveCluster(restAPIMock, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resolved.Name != name {
+			t.Fatal("Cluster", cluster, "resolved to", resolved.Name)
+		}
+	}
+}
+
+// TestResolveClusterLeadingZeros checks that numbers with leading zeros are
+// considered to be prefixes of cluster name, not numeric IDs
+func TestResolveClusterLeadingZeros(t *testing.T) {
+	for _, cluster := range []string{"000", "00000000", "00000000-0000"} {
+		resolved, err := commands.ResolveCluster(RestAPIMock{}, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resolved.Name != "00000000-0000-0000-0000-000000000000" {
+			t.Fatal("Cluster", cluster, "resolved to", resolved.Name)
+		}
+	}
+}
+
+// TestResolveClusterAmbiguous checks that ambiguous specification is refused
+func TestResolveClusterAmbiguous(t *testing.T) {
+	// two clusters have the same ID in mocked data
+	_, err := commands.ResolveCluster(RestAPIMock{}, "0")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatal("Ambiguity should be reported, got:", err)
+	}
+}
+
+// TestResolveClusterIDAndPrefix checks that numeric ID matching other
+// cluster than name prefix is refused
+func TestResolveClusterIDAndPrefix(t *testing.T) {
+	_, err := commands.ResolveCluster(idPrefixClashMock{}, "12")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") ||
+		!strings.Contains(err.Error(), "12 (c8590f31-e97e-4b85-b506-c45ce1911a12)") ||
+		!strings.Contains(err.Error(), "13 (12345678-0000-0000-0000-000000000000)") {
+		t.Fatal("Ambiguity should be reported, got:", err)
+	}
+
+	// longer number matches just name prefix
+	resolved, err := commands.ResolveCluster(idPrefixClashMock{}, "1234")
+	if err != nil || resolved.ID != 13 {
+		t.Fatal("Unexpected cluster:", resolved, err)
+	}
+
+	// ID that matches just one cluster
+	resolved, err = commands.ResolveCluster(idPrefixClashMock{}, "13")
+	if err != nil || resolved.ID != 13 {
+		t.Fatal("Unexpected cluster:", resolved, err)
+	}
+}
+
+// TestResolveClusterNotFound checks that unknown cluster is reported
+func TestResolveClusterNotFound(t *testing.T) {
+	for _, cluster := range []string{"", "42", "xyzzy"} {
+		_, err := commands.ResolveCluster(RestAPIMock{}, cluster)
+		if err == nil {
+			t.Fatal("Error is expected for cluster:", cluster)
+		}
+	}
+}
+
+// TestResolveClusterError checks that error returned by REST API is reported
+func TestResolveClusterError(t *testing.T) {
+	_, err := commands.ResolveCluster(RestAPIMockErrors{}, "1")
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+}
+
+// TestDeleteClusterByID checks that cluster can be deleted by its ID
+func TestDeleteClusterByID(t *testing.T) {
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		commands.DeleteCluster(RestAPIMock{}, "1", false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Cluster 00000000-0000-0000-0000-000000000000 has been deleted") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDeleteClusterAmbiguous checks that ambiguous cluster is not deleted
+func TestDeleteClusterAmbiguous(t *testing.T) {
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		commands.DeleteCluster(RestAPIMock{}, "0", false)
+	})
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.HasPrefix(captured, "Can not resolve cluster") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
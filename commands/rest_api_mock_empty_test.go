@@ -160,6 +160,14 @@ func (api RestAPIMockEmpty) AddConfigurationProfile(username, description string
 	return nil
 }
 
+// UpdateConfigurationProfile access the REST API endpoint to update existing
+// configuration profile.
+// This is a mock implementation of original method.
+func (api RestAPIMockEmpty) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	// return mocked response with empty data structure
+	return nil
+}
+
 // AddClusterConfiguration access the REST API endpoint to add new cluster
 // configuration.
 // This is a mock implementation of original method.
@@ -175,6 +183,14 @@ func (api RestAPIMockEmpty) AddTrigger(username, clusterName, reason, link strin
 	return nil
 }
 
+// AddTypedTrigger access the REST API endpoint to add/register new trigger
+// of given type.
+// This is a mock implementation of original method.
+func (api RestAPIMockEmpty) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	// return mocked response with empty data structure
+	return nil
+}
+
 // DeleteTrigger access the REST API endpoint to delete the selected trigger.
 // This is a mock implementation of original method.
 func (api RestAPIMockEmpty) DeleteTrigger(triggerID string) error {
@@ -150,6 +150,14 @@ func (api RestAPIMockErrors) AddConfigurationProfile(username, description strin
 	return errors.New("AddConfigurationProfile error")
 }
 
+// UpdateConfigurationProfile access the REST API endpoint to update existing
+// configuration profile.
+// This is a mock implementation of original method.
+func (api RestAPIMockErrors) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	// return mocked response with error structure
+	return errors.New("UpdateConfigurationProfile error")
+}
+
 // AddClusterConfiguration returns an error as its last return value.
 // This is a mock implementation of original method.
 func (api RestAPIMockErrors) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
@@ -164,6 +172,13 @@ func (api RestAPIMockErrors) AddTrigger(username, clusterName, reason, link stri
 	return errors.New("AddTrigger error")
 }
 
+// AddTypedTrigger returns an error as its last return value.
+// This is a mock implementation of original method.
+func (api RestAPIMockErrors) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	// return mocked response with error structure
+	return errors.New("AddTypedTrigger error")
+}
+
 // DeleteTrigger returns an error as its last return value.
 // This is a mock implementation of original method.
 func (api RestAPIMockErrors) DeleteTrigger(triggerID string) error {
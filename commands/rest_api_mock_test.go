@@ -149,6 +149,20 @@ func (api RestAPIMock) ReadTriggerByID(triggerID string) (*types.Trigger, error)
 			Parameters:  "-a -W",
 			Active:      0}
 		return &trigger, nil
+	case "3":
+		// data structure to be returned
+		trigger := types.Trigger{
+			ID:          3,
+			Type:        "custom-gather",
+			Cluster:     "00000000-0000-0000-0000-000000000000",
+			Reason:      "missing data",
+			Link:        "https://www.webpagetest.org/",
+			TriggeredAt: "2020-01-01T00:00:00",
+			TriggeredBy: "tester",
+			AckedAt:     "1970-01-01T00:00:00",
+			Parameters:  `{"upload":true,"gatherers":["clusterconfig","workloads"]}`,
+			Active:      1}
+		return &trigger, nil
 	}
 	trigger := types.TriggerResponse{}
 
@@ -187,7 +201,7 @@ func (api RestAPIMock) ReadListOfConfigurations() ([]types.ClusterConfiguration,
 	configurations := []types.ClusterConfiguration{
 		{
 			ID:            0,
-			Cluster:       "0",
+			Cluster:       "00000000-0000-0000-0000-000000000000",
 			Configuration: "0",
 			ChangedAt:     "2020-01-01T00:00:00",
 			ChangedBy:     "tester",
@@ -195,7 +209,7 @@ func (api RestAPIMock) ReadListOfConfigurations() ([]types.ClusterConfiguration,
 			Reason:        "configuration1"},
 		{
 			ID:            1,
-			Cluster:       "0",
+			Cluster:       "00000000-0000-0000-0000-000000000000",
 			Configuration: "1",
 			ChangedAt:     "2020-01-01T00:00:00",
 			ChangedBy:     "tester",
@@ -203,7 +217,7 @@ func (api RestAPIMock) ReadListOfConfigurations() ([]types.ClusterConfiguration,
 			Reason:        "configuration2"},
 		{
 			ID:            2,
-			Cluster:       "0",
+			Cluster:       "00000000-0000-0000-0000-000000000000",
 			Configuration: "2",
 			ChangedAt:     "2020-01-01T00:00:00",
 			ChangedBy:     "tester",
@@ -306,6 +320,14 @@ func (api RestAPIMock) AddConfigurationProfile(username, description string, con
 	return nil
 }
 
+// UpdateConfigurationProfile access the REST API endpoint to update existing
+// configuration profile.
+// This is a mock implementation of original method.
+func (api RestAPIMock) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	// return mocked response
+	return nil
+}
+
 // AddClusterConfiguration access the REST API endpoint to add new cluster.
 // configuration
 // This is a mock implementation of original method.
@@ -321,6 +343,14 @@ func (api RestAPIMock) AddTrigger(username, clusterName, reason, link string) er
 	return nil
 }
 
+// AddTypedTrigger access the REST API endpoint to add/register new trigger
+// of given type.
+// This is a mock implementation of original method.
+func (api RestAPIMock) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	// return mocked response
+	return nil
+}
+
 // DeleteTrigger access the REST API endpoint to delete the selected trigger.
 // This is a mock implementation of original method.
 func (api RestAPIMock) DeleteTrigger(triggerID string) error {
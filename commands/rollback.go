@@ -0,0 +1,242 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/rollback.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+	"github.com/c-bata/go-prompt"
+)
+
+// rollbackLog contains name of file where all rollbacks are recorded. REST
+// API does not allow to store reason of enabling or disabling configuration,
+// so the reason is recorded locally.
+var rollbackLog string
+
+// SetRollbackLog function sets name of file where all rollbacks are recorded
+// together with their reasons. Empty name disables the recording.
+func SetRollbackLog(fileName string) {
+	rollbackLog = fileName
+}
+
+// rollbackRecord represents one rollback stored in rollback log
+type rollbackRecord struct {
+	Timestamp string `json:"timestamp"`
+	Username  string `json:"username"`
+	Cluster   string `json:"cluster"`
+	From      int    `json:"from"`
+	To        int    `json:"to"`
+	Reason    string `json:"reason"`
+}
+
+// recordRollback function appends the rollback record into rollback log
+// (one JSON document per line)
+func recordRollback(record rollbackRecord) error {
+	if rollbackLog == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(rollbackLog), 0o700)
+	if err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	file, err := os.OpenFile(rollbackLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) // #nosec G304
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// currentConfigurationIndex function returns index of the current (newest
+// active) configuration in cluster history or -1 if no configuration is
+// active
+func currentConfigurationIndex(history []types.ClusterConfiguration) int {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Active == "1" {
+			return i
+		}
+	}
+	return -1
+}
+
+// rollbackTargetIndex function finds the configuration the cluster should be
+// rolled back to. Target is specified either by configuration ID or by
+// number of steps back in the history.
+func rollbackTargetIndex(history []types.ClusterConfiguration, current int, to string, steps int) (int, error) {
+	if to == "" {
+		target := current - steps
+		if target < 0 {
+			return -1, fmt.Errorf("can not go %d steps back, there are just %d older configurations", steps, current)
+		}
+		return target, nil
+	}
+
+	for i, configuration := range history {
+		if strconv.Itoa(configuration.ID) != to {
+			continue
+		}
+		if i == current {
+			return -1, fmt.Errorf("configuration %s is the current configuration", to)
+		}
+		return i, nil
+	}
+	return -1, fmt.Errorf("configuration %s does not belong to the cluster", to)
+}
+
+// RollbackCluster function makes older configuration of selected cluster
+// active again. The target configuration is selected by --to flag (its ID)
+// or by --steps flag (number of steps back in the cluster history, one by
+// default). Preview diff is displayed before the rollback is performed and
+// the reason of rollback is recorded.
+func RollbackCluster(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet("rollback cluster")
+	to := flags.String("to", "", "ID of configuration to roll back to")
+	steps := flags.Int("steps", 0, "number of steps back in the cluster history")
+	reason := flags.String("reason", "", "reason for the rollback")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 1:
+		err = fmt.Errorf("exactly one cluster needs to be specified")
+	case *to != "" && *steps != 0:
+		err = fmt.Errorf("--to and --steps can not be used together")
+	case *steps < 0:
+		err = fmt.Errorf("number of steps needs to be positive")
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	if *to == "" && *steps == 0 {
+		*steps = 1
+	}
+
+	cluster, ok := resolveClusterOrReport(api, positional[0])
+	if !ok {
+		return errors.New(CannotResolveClusterErrorMessage)
+	}
+
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorReadingListOfConfigurations))
+		fmt.Println(err)
+		return err
+	}
+	history := clusterHistory(configurations, cluster.Name)
+
+	current := currentConfigurationIndex(history)
+	if current < 0 {
+		err = fmt.Errorf("cluster %s has no active configuration", cluster.Name)
+	}
+	target := -1
+	if err == nil {
+		target, err = rollbackTargetIndex(history, current, *to, *steps)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotRollbackErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	from := history[current]
+	into := history[target]
+
+	// preview of changes
+	fromPayload, err := readConfigurationPayload(api, from.ID)
+	if err == nil {
+		var intoPayload string
+		intoPayload, err = readConfigurationPayload(api, into.ID)
+		if err == nil {
+			fmt.Println(colorizer.Magenta(fmt.Sprintf("Rollback of cluster %s from configuration %d to configuration %d",
+				cluster.Name, from.ID, into.ID)))
+			printPayloadDiff(fromPayload, intoPayload)
+		}
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// ask user about reason if it is not specified on command line
+	if *reason == "" {
+		*reason = prompt.Input(reasonPrompt, LoginCompleter)
+		if *reason == "" {
+			fmt.Println(colorizer.Red(operationCancelled))
+			return nil
+		}
+	}
+
+	if askForConfirmation {
+		question := fmt.Sprintf("Configuration %d will be disabled and configuration %d enabled", from.ID, into.ID)
+		if !ProceedQuestion(question) {
+			return nil
+		}
+	}
+
+	_, err = activateExclusively(api, history, target)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotRollbackErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	err = recordRollback(rollbackRecord{
+		Timestamp: currentTime().UTC().Format(time.RFC3339),
+		Username:  username,
+		Cluster:   cluster.Name,
+		From:      from.ID,
+		To:        into.ID,
+		Reason:    *reason,
+	})
+	if err != nil {
+		// rollback itself has been finished
+		fmt.Println(colorizer.Red("Reason of rollback can not be recorded"))
+		fmt.Println(err)
+	}
+
+	fmt.Println(colorizer.Blue(fmt.Sprintf("Cluster %s has been rolled back to configuration %d", cluster.Name, into.ID)))
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking rollback of cluster configuration.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/rollback_test.html
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// setRollbackLog is a helper function that redirects rollback log into
+// temporary file
+func setRollbackLog(t *testing.T) string {
+	fileName := filepath.Join(t.TempDir(), "rollbacks.jsonl")
+	commands.SetRollbackLog(fileName)
+	t.Cleanup(func() {
+		commands.SetRollbackLog("")
+	})
+	return fileName
+}
+
+// TestRollbackClusterOneStep function checks the rollback to the previous
+// configuration.
+func TestRollbackClusterOneStep(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	logFile := setRollbackLog(t)
+	defer commands.SetCurrentTime(time.Date(2020, 1, 10, 12, 0, 0, 0, time.FixedZone("CET", 3600)))()
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.RollbackCluster(api, "tester", []string{"1", "--reason", "broken gathering"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	expected := []string{
+		"Rollback of cluster 00000000-0000-0000-0000-000000000000 from configuration 1 to configuration 0",
+		"+ configuration#0",
+		"Cluster 00000000-0000-0000-0000-000000000000 has been rolled back to configuration 0",
+	}
+	for _, e := range expected {
+		if !strings.Contains(captured, e) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+
+	// the target configuration is already active
+	if strings.Join(*api.calls, ",") != "disable 1" {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+
+	// reason needs to be recorded
+	content, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var record map[string]interface{}
+	err = json.Unmarshal(content, &record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["reason"] != "broken gathering" || record["username"] != "tester" ||
+		record["from"] != 1.0 || record["to"] != 0.0 || record["timestamp"] != "2020-01-10T11:00:00Z" {
+		t.Fatal("Unexpected rollback record:", string(content))
+	}
+}
+
+// TestRollbackClusterTo function checks the rollback to configuration
+// selected by its ID.
+func TestRollbackClusterTo(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	setRollbackLog(t)
+
+	api := newConfigurationStoreMock()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.RollbackCluster(api, "tester", []string{"1", "--to", "2", "--reason", "x"}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	// target needs to be enabled before other configurations are disabled
+	if strings.Join(*api.calls, ",") != "enable 2,disable 0,disable 1" {
+		t.Fatal("Unexpected calls:", *api.calls)
+	}
+}
+
+// TestRollbackClusterErrors function checks the error handling in rollback
+// command.
+func TestRollbackClusterErrors(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	setRollbackLog(t)
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"no cluster", []string{}, "exactly one cluster needs to be specified"},
+		{"both flags", []string{"1", "--to", "0", "--steps", "1"}, "--to and --steps can not be used together"},
+		{"negative steps", []string{"1", "--steps", "-1"}, "number of steps needs to be positive"},
+		{"too many steps", []string{"1", "--steps", "2"}, "can not go 2 steps back, there are just 1 older configurations"},
+		{"current configuration", []string{"1", "--to", "1"}, "configuration 1 is the current configuration"},
+		{"foreign configuration", []string{"1", "--to", "42"}, "configuration 42 does not belong to the cluster"},
+		{"no active configuration", []string{"ffff"}, "has no active configuration"},
+		{"unknown cluster", []string{"xyzzy"}, "Can not resolve cluster"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newConfigurationStoreMock()
+
+			captured, err := capture.StandardOutput(func() {
+				err := commands.RollbackCluster(api, "tester", append(tc.args, "--reason", "x"), false)
+				if err == nil {
+					t.Fatal("Error is expected")
+				}
+			})
+
+			// check if capture was done correctly
+			checkCapturedOutput(t, captured, err)
+
+			if !strings.Contains(captured, tc.expected) {
+				t.Fatal("Unexpected output:\n", captured)
+			}
+			if len(*api.calls) != 0 {
+				t.Fatal("No configuration should be changed:", *api.calls)
+			}
+		})
+	}
+}
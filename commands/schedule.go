@@ -0,0 +1,389 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/schedule.html
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/declarative"
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/schedule"
+	"github.com/c-bata/go-prompt"
+	"github.com/kballard/go-shellquote"
+)
+
+const jobMessage = "Job "
+
+// commands that can be scheduled
+const scheduledCommands = "request must-gather, add trigger, enable configuration, disable configuration, apply"
+
+// scheduleStore contains store of scheduled jobs, nil when jobs can not be
+// stored
+var scheduleStore *schedule.Store
+
+// SetScheduleFile function sets name of file where scheduled jobs are
+// stored.
+func SetScheduleFile(fileName string) {
+	if fileName == "" {
+		scheduleStore = nil
+		return
+	}
+	scheduleStore = schedule.NewStore(fileName)
+}
+
+// checkScheduleStore function checks that scheduled jobs can be stored
+func checkScheduleStore() error {
+	if scheduleStore != nil {
+		return nil
+	}
+	err := errors.New("file with scheduled jobs is not configured")
+	fmt.Println(colorizer.Red(CannotAccessSchedulesErrorMessage))
+	fmt.Println(err)
+	return err
+}
+
+// scheduledTrigger function prepares action that creates trigger, the
+// arguments are the same as arguments of add trigger command
+func scheduledTrigger(api restapi.API, command string, args []string) (*schedule.Action, error) {
+	flags := newFlagSet(command)
+	options := addTriggerFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(positional) != 1 {
+		return nil, reportInvalidArguments("exactly one cluster needs to be specified")
+	}
+	triggerType, parameters, err := options.typeAndParameters()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, ok := resolveClusterOrReport(api, positional[0])
+	if !ok {
+		return nil, errors.New(CannotResolveClusterErrorMessage)
+	}
+	if options.reason == "" {
+		options.reason = prompt.Input(reasonPrompt, LoginCompleter)
+	}
+
+	return &schedule.Action{
+		Kind:        schedule.AddTrigger,
+		Cluster:     cluster.Name,
+		TriggerType: triggerType.Name,
+		Reason:      options.reason,
+		Link:        options.link,
+		Parameters:  parameters,
+	}, nil
+}
+
+// scheduledConfigurationChange function prepares action that enables or
+// disables configuration selected by its ID
+func scheduledConfigurationChange(kind schedule.ActionKind, args []string) (*schedule.Action, error) {
+	if len(args) != 1 {
+		return nil, reportInvalidArguments("exactly one configuration ID needs to be specified")
+	}
+	if _, err := strconv.Atoi(args[0]); err != nil {
+		return nil, reportInvalidArguments("invalid configuration ID: " + args[0])
+	}
+	return &schedule.Action{Kind: kind, ConfigurationID: args[0]}, nil
+}
+
+// scheduledApply function prepares action that applies manifest, the
+// manifest is checked before the job is scheduled
+func scheduledApply(args []string) (*schedule.Action, error) {
+	flags := newFlagSet("apply")
+	options := addManifestFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(positional) != 0 || options.file == "" {
+		return nil, reportInvalidArguments("manifest needs to be specified by -f flag")
+	}
+
+	// daemon can be started from different directory
+	manifest, err := filepath.Abs(options.file)
+	if err == nil {
+		_, err = declarative.LoadManifest(manifest)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotReadManifestErrorMessage))
+		fmt.Println(err)
+		return nil, err
+	}
+	return &schedule.Action{Kind: schedule.ApplyManifest, Manifest: manifest, Prune: options.prune}, nil
+}
+
+// scheduledAction function converts command entered by user into action
+// performed by scheduled job
+func scheduledAction(api restapi.API, args []string) (*schedule.Action, error) {
+	if len(args) > 0 && args[0] == "apply" {
+		return scheduledApply(args[1:])
+	}
+
+	command := strings.Join(args, " ")
+	if len(args) >= 2 {
+		switch args[0] + " " + args[1] {
+		case "request must-gather", "add trigger":
+			return scheduledTrigger(api, args[0]+" "+args[1], args[2:])
+		case "enable configuration":
+			return scheduledConfigurationChange(schedule.EnableConfiguration, args[2:])
+		case "disable configuration":
+			return scheduledConfigurationChange(schedule.DisableConfiguration, args[2:])
+		}
+	}
+	return nil, reportInvalidArguments("command can not be scheduled: " + command +
+		" (supported: " + scheduledCommands + ")")
+}
+
+// Schedule function schedules command to be performed by daemon, either
+// repeatedly according to cron expression (--cron flag) or just once at
+// given time (--at flag). Flags of schedule command need to precede the
+// scheduled command.
+func Schedule(api restapi.API, username string, args []string) error {
+	flags := newFlagSet("schedule")
+	cron := flags.String("cron", "", "cron expression, for example \"0 2 * * *\" or @daily")
+	at := flags.String("at", "", "time of one-shot job, for example \"2026-10-20 02:00\" or 02:00")
+
+	// flags of the scheduled command must not be parsed here
+	err := flags.Parse(args)
+	if err != nil {
+		fmt.Println(colorizer.Red(InvalidCommandArgumentsErrorMessage))
+		return err
+	}
+	command := flags.Args()
+
+	now := currentTime()
+	job := schedule.Job{Cron: *cron, Command: shellquote.Join(command...)}
+	if *at != "" {
+		runAt, err := schedule.ParseAt(*at, now)
+		if err != nil {
+			return reportInvalidArguments(err.Error())
+		}
+		job.At = &runAt
+	}
+	if err := job.Start(now); err != nil {
+		return reportInvalidArguments(err.Error())
+	}
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+	if err := checkScheduleStore(); err != nil {
+		return err
+	}
+
+	action, err := scheduledAction(api, command)
+	if err != nil {
+		return err
+	}
+	job.Action = *action
+	job.Username = username
+
+	job, err = scheduleStore.Add(job)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotAccessSchedulesErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	fmt.Println(colorizer.Blue(jobMessage+strconv.Itoa(job.ID)+" has been scheduled, next run at"),
+		job.NextRun.Format(time.RFC1123))
+	return nil
+}
+
+// formatRunTime function returns time of job run or dash for missing time
+func formatRunTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// ListOfSchedules function displays all scheduled jobs together with the
+// result of their last run.
+func ListOfSchedules() {
+	if checkScheduleStore() != nil {
+		return
+	}
+	jobs, err := scheduleStore.Load()
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotAccessSchedulesErrorMessage))
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(colorizer.Magenta("List of scheduled jobs"))
+	fmt.Printf("%4s %-22s %-16s %-16s %-7s %-12s %s\n", "ID", "Schedule", "Next run", "Last run", "Result", "Username", "Command")
+	for _, job := range jobs {
+		var result fmt.Stringer
+		switch {
+		case job.LastResult == "":
+			result = colorizer.Gray(12, "-")
+		case strings.HasPrefix(job.LastResult, "failed"):
+			result = colorizer.Red("failed")
+		case job.LastResult == schedule.ResultRunning:
+			result = colorizer.Yellow(job.LastResult)
+		default:
+			result = colorizer.Green(job.LastResult)
+		}
+		fmt.Printf("%4d %-22s %-16s %-16s %-7s %-12s %s\n", job.ID, job.Schedule(),
+			formatRunTime(&job.NextRun), formatRunTime(job.LastRun), result, job.Username, job.Command)
+		if strings.HasPrefix(job.LastResult, "failed") {
+			fmt.Println("     ", job.LastResult)
+		}
+	}
+}
+
+// CancelSchedule function removes scheduled job selected by its ID.
+func CancelSchedule(args []string, askForConfirmation bool) error {
+	if len(args) != 1 {
+		return reportInvalidArguments("exactly one job ID needs to be specified")
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return reportInvalidArguments("invalid job ID: " + args[0])
+	}
+	if err := checkScheduleStore(); err != nil {
+		return err
+	}
+
+	if askForConfirmation {
+		if !ProceedQuestion("Scheduled job " + args[0] + " will be cancelled") {
+			return nil
+		}
+	}
+
+	job, err := scheduleStore.Remove(id)
+	if err != nil {
+		fmt.Println(colorizer.Red(CannotAccessSchedulesErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	fmt.Println(colorizer.Blue(jobMessage+args[0]+hasBeenMessage), colorizer.Red("cancelled"), job.Command)
+	return nil
+}
+
+// runDueJobs function executes all jobs that need to be run and records
+// their results. Number of executed and failed jobs is returned.
+func runDueJobs(api restapi.API) (int, int) {
+	timestamp := func() string {
+		return currentTime().Format(time.RFC3339)
+	}
+
+	// jobs are claimed before they are run, so they are not run by another
+	// daemon and cancelled jobs are not run
+	jobs, err := scheduleStore.Claim(currentTime())
+	if err != nil {
+		fmt.Println(timestamp(), colorizer.Red(CannotAccessSchedulesErrorMessage+": "+err.Error()))
+		return 0, 1
+	}
+
+	executed, failed := 0, 0
+	for _, job := range jobs {
+		executed++
+		result := schedule.Execute(api, job)
+		if result != nil {
+			failed++
+			fmt.Println(timestamp(), jobMessage+strconv.Itoa(job.ID), colorizer.Red("failed:"), job.Command+":", result)
+		} else {
+			fmt.Println(timestamp(), jobMessage+strconv.Itoa(job.ID), colorizer.Green("ok:"), job.Command)
+		}
+
+		err := scheduleStore.Finish(job.ID, currentTime(), result)
+		if err != nil {
+			fmt.Println(timestamp(), colorizer.Red(CannotAccessSchedulesErrorMessage+": "+err.Error()))
+		}
+	}
+	return executed, failed
+}
+
+// Daemon function runs scheduled jobs at their time until it is interrupted
+// by Ctrl+C. When notifications are configured, state changes of triggers and
+// configurations are detected and sent with each poll. With --once flag just
+// the jobs that need to be run now are executed.
+func Daemon(api restapi.API, args []string) error {
+	flags := newFlagSet("daemon")
+	poll := flags.Duration("poll", 30*time.Second, "how often scheduled jobs are checked")
+	once := flags.Bool("once", false, "run due jobs once and exit")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 0:
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional, " "))
+	case *poll <= 0:
+		return reportInvalidArguments("poll interval needs to be positive")
+	}
+	if err := checkScheduleStore(); err != nil {
+		return err
+	}
+
+	if *once {
+		executed, failed := runDueJobs(api)
+		if executed == 0 && failed == 0 {
+			fmt.Println(colorizer.Blue("No scheduled jobs to run"))
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d scheduled jobs failed", failed)
+		}
+		return nil
+	}
+
+	// daemon is stopped by Ctrl+C
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	// state of triggers and configurations is checked with each poll when
+	// notifications are configured
+	var snapshot *notifications.Snapshot
+
+	fmt.Println(currentTime().Format(time.RFC3339), colorizer.Blue("Daemon started"))
+	for {
+		runDueJobs(api)
+		snapshot = checkStateChanges(api, snapshot)
+		select {
+		case <-interrupted:
+			flushNotifications(time.RFC3339)
+			fmt.Println(currentTime().Format(time.RFC3339), colorizer.Blue("Daemon stopped"))
+			return nil
+		case <-after(*poll):
+		}
+	}
+}
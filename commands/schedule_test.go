@@ -0,0 +1,246 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking scheduling of jobs and their execution by daemon.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/schedule_test.html
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// scheduledAt is time when jobs are scheduled in tests (Thursday)
+var scheduledAt = time.Date(2026, 10, 15, 10, 30, 0, 0, time.UTC)
+
+// useScheduleFile function configures temporary file with scheduled jobs
+func useScheduleFile(t *testing.T) {
+	commands.SetScheduleFile(filepath.Join(t.TempDir(), "schedules.json"))
+	t.Cleanup(func() {
+		commands.SetScheduleFile("")
+	})
+}
+
+// runCommand is a helper function that runs command at given time and
+// returns its output and error
+func runCommand(t *testing.T, now time.Time, command func() error) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.SetCurrentTime(now)()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = command()
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// schedule is a helper function that runs schedule command
+func schedule(t *testing.T, api restapi.API, username string, args ...string) (string, error) {
+	return runCommand(t, scheduledAt, func() error {
+		return commands.Schedule(api, username, args)
+	})
+}
+
+// listOfSchedules is a helper function that runs list schedules command
+func listOfSchedules(t *testing.T) string {
+	captured, _ := runCommand(t, scheduledAt, func() error {
+		commands.ListOfSchedules()
+		return nil
+	})
+	return captured
+}
+
+// TestScheduleTrigger function checks scheduling of recurring must-gather.
+func TestScheduleTrigger(t *testing.T) {
+	useScheduleFile(t)
+
+	captured, err := schedule(t, RestAPIMock{}, "tester", "--cron", "0 2 * * *",
+		"request", "must-gather", "00000000", "--reason", "nightly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured != "Job 1 has been scheduled, next run at Fri, 16 Oct 2026 02:00:00 UTC\n" {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+
+	captured = listOfSchedules(t)
+	expected := "   1 cron 0 2 * * *         2026-10-16 02:00 -                -       tester       " +
+		"request must-gather 00000000 --reason nightly\n"
+	if !strings.HasSuffix(captured, expected) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestScheduleInvalid function checks that invalid schedules and commands
+// are refused and nothing is stored.
+func TestScheduleInvalid(t *testing.T) {
+	useScheduleFile(t)
+
+	invalid := [][]string{
+		{"request", "must-gather", "00000000", "--reason", "x"},
+		{"--cron", "0 25 * * *", "request", "must-gather", "00000000"},
+		{"--at", "yesterday", "request", "must-gather", "00000000"},
+		{"--at", "2020-01-01 00:00", "enable", "configuration", "1"},
+		{"--at", "12:00", "delete", "cluster", "1"},
+		{"--at", "12:00", "enable", "configuration", "foo"},
+		{"--at", "12:00", "add", "trigger", "00000000", "--type", "unknown"},
+		{"--at", "12:00", "apply", "-f", "missing.yaml"},
+	}
+	for _, args := range invalid {
+		if _, err := schedule(t, RestAPIMock{}, "tester", args...); err == nil {
+			t.Error("Command should be refused:", args)
+		}
+	}
+	if strings.Contains(listOfSchedules(t), "tester") {
+		t.Fatal("No job should be stored")
+	}
+}
+
+// TestScheduleNotLoggedIn function checks that user needs to be logged in.
+func TestScheduleNotLoggedIn(t *testing.T) {
+	useScheduleFile(t)
+
+	captured, err := schedule(t, RestAPIMock{}, "", "--at", "12:00", "enable", "configuration", "1")
+	if err == nil || !strings.Contains(captured, "Not logged in") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestScheduleNotConfigured function checks that missing file with jobs is
+// reported.
+func TestScheduleNotConfigured(t *testing.T) {
+	commands.SetScheduleFile("")
+
+	captured, err := schedule(t, RestAPIMock{}, "tester", "--at", "12:00", "enable", "configuration", "1")
+	if err == nil || !strings.Contains(captured, commands.CannotAccessSchedulesErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestCancelSchedule function checks cancelling of scheduled job.
+func TestCancelSchedule(t *testing.T) {
+	useScheduleFile(t)
+
+	_, err := schedule(t, RestAPIMock{}, "tester", "--at", "12:00", "enable", "configuration", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	captured, err := runCommand(t, scheduledAt, func() error {
+		return commands.CancelSchedule([]string{"1"}, false)
+	})
+	if err != nil || captured != "Job 1 has been cancelled enable configuration 1\n" {
+		t.Fatal("Unexpected output:\n", captured, err)
+	}
+
+	_, err = runCommand(t, scheduledAt, func() error {
+		return commands.CancelSchedule([]string{"1"}, false)
+	})
+	if err == nil {
+		t.Fatal("Cancelled job should not be found")
+	}
+}
+
+// TestDaemonOnce function checks that daemon runs due jobs and records their
+// results.
+func TestDaemonOnce(t *testing.T) {
+	useScheduleFile(t)
+
+	calls := []string{}
+	api := typedTriggerMock{calls: &calls}
+
+	_, err := schedule(t, api, "tester", "--cron", "@daily", "add", "trigger", "00000000", "--reason", "nightly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = schedule(t, api, "tester", "--at", "2026-10-17 08:00", "enable", "configuration", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = schedule(t, api, "tester", "--at", "2026-10-16 00:00", "enable", "configuration", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first and the third job are due
+	runAt := time.Date(2026, 10, 16, 0, 0, 30, 0, time.UTC)
+	captured, err := runCommand(t, runAt, func() error {
+		return commands.Daemon(api, []string{"--once"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "2026-10-16T00:00:30Z Job 1 ok: add trigger 00000000 --reason nightly\n" +
+		"2026-10-16T00:00:30Z Job 3 ok: enable configuration 2\n"
+	if captured != expected {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if len(calls) != 1 || calls[0] != "tester 00000000-0000-0000-0000-000000000000 must-gather nightly  " {
+		t.Fatal("Unexpected calls:", calls)
+	}
+
+	captured = listOfSchedules(t)
+	for _, line := range []string{
+		"   1 cron @daily            2026-10-17 00:00 2026-10-16 00:00 ok ",
+		"   2 at 2026-10-17 08:00    2026-10-17 08:00 -                -  ",
+		"   3 at 2026-10-16 00:00    -                2026-10-16 00:00 ok ",
+	} {
+		if !strings.Contains(captured, line) {
+			t.Fatalf("Expected %q in output:\n%s", line, captured)
+		}
+	}
+
+	// nothing is due now
+	captured, err = runCommand(t, runAt, func() error {
+		return commands.Daemon(api, []string{"--once"})
+	})
+	if err != nil || captured != "No scheduled jobs to run\n" {
+		t.Fatal("Unexpected output:\n", captured, err)
+	}
+}
+
+// TestDaemonFailedJob function checks that failed jobs are reported.
+func TestDaemonFailedJob(t *testing.T) {
+	useScheduleFile(t)
+
+	_, err := schedule(t, RestAPIMockErrors{}, "tester", "--at", "11:00", "disable", "configuration", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	captured, err := runCommand(t, scheduledAt.Add(time.Hour), func() error {
+		return commands.Daemon(RestAPIMockErrors{}, []string{"--once"})
+	})
+	if err == nil || !strings.Contains(captured, "Job 1 failed: disable configuration 1:") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if !strings.Contains(listOfSchedules(t), "\n      failed: ") {
+		t.Fatal("Error should be displayed in list of jobs")
+	}
+}
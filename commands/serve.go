@@ -0,0 +1,242 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/serve.html
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/alerts"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
+)
+
+// defaultAlertReceiverAddress is the address where receiver of Alertmanager
+// notifications listens by default, it is reachable from local host only
+const defaultAlertReceiverAddress = "127.0.0.1:9099"
+
+// timeouts of alert receiver server, writing of response includes creating
+// triggers for all alerts in notification
+const (
+	alertReceiverReadTimeout  = 30 * time.Second
+	alertReceiverWriteTimeout = 2 * time.Minute
+	alertReceiverIdleTimeout  = 2 * time.Minute
+)
+
+// loopbackAddress function checks whether the server listening on given
+// address is reachable from local host only
+func loopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		// invalid address is reported when the server is started
+		return true
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// readToken function reads bearer token from the first line of given file
+func readToken(filename string) (string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(strings.SplitN(string(content), "\n", 2)[0])
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", filename)
+	}
+	return token, nil
+}
+
+// duplicateAlertTrigger function checks triggers known to the controller
+// and decides whether new must-gather trigger for the cluster would be a
+// duplicate. Trigger created since the given time is always a duplicate,
+// pending trigger is a duplicate only when duplicate triggers are refused,
+// otherwise it is just reported.
+func duplicateAlertTrigger(api restapi.API, cluster string, since time.Time) (bool, string, error) {
+	list, err := api.ReadListOfTriggers()
+	if err != nil {
+		return false, "", fmt.Errorf("%s: %v", ErrorReadingListOfTriggers, err)
+	}
+
+	for _, trigger := range list {
+		if trigger.Cluster != cluster || trigger.Type != triggers.MustGather {
+			continue
+		}
+		triggeredAt, err := parseTimestamp(trigger.TriggeredAt)
+		if err == nil && triggeredAt.After(since) {
+			return true, fmt.Sprintf("trigger %d has been created at %s",
+				trigger.ID, displayedTimestamp(trigger.TriggeredAt)), nil
+		}
+	}
+
+	trigger := pendingTrigger(list, cluster, triggers.MustGather)
+	if trigger == nil {
+		return false, "", nil
+	}
+	return refuseDuplicateTriggers, fmt.Sprintf("trigger %d has not been acknowledged yet", trigger.ID), nil
+}
+
+// commaSeparated function returns non-empty items of comma separated list
+func commaSeparated(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// reportAlert function displays result of processing an alert
+func reportAlert(result alerts.Result) {
+	var action fmt.Stringer
+	switch result.Action {
+	case alerts.Created:
+		action = colorizer.Green("trigger created")
+	case alerts.Failed:
+		action = colorizer.Red("failed")
+	default:
+		action = colorizer.Yellow("skipped")
+	}
+	line := fmt.Sprintf("%s alert %s", currentTime().Format(time.RFC3339), result.Alert)
+	if result.Cluster != "" {
+		line += " for cluster " + result.Cluster
+	}
+	if result.Message != "" {
+		fmt.Println(line+":", action, "("+result.Message+")")
+	} else {
+		fmt.Println(line+":", action)
+	}
+}
+
+// ServeAlertReceiver function starts HTTP server that accepts Alertmanager
+// webhook notifications and creates must-gather triggers for clusters with
+// firing alerts. Cluster is found by labels specified by --cluster-label
+// flag, only alerts specified by --alerts flag are accepted (if the flag is
+// used) and just one trigger is created for a cluster in time specified by
+// --dedup flag. Bearer token read from file specified by --token-file flag is
+// required when the receiver listens on address reachable from other hosts.
+// The server is stopped by Ctrl+C.
+func ServeAlertReceiver(api restapi.API, username string, args []string) error {
+	flags := newFlagSet("serve alert-receiver")
+	listen := flags.String("listen", defaultAlertReceiverAddress, "address where the receiver listens")
+	labels := flags.String("cluster-label", strings.Join(alerts.DefaultClusterLabels, ","),
+		"comma separated list of labels containing cluster name or ID")
+	allowed := flags.String("alerts", "", "comma separated list of accepted alert names, all alerts are accepted by default")
+	dedup := flags.String("dedup", formatDuration(alerts.DefaultDedupWindow), "time in which just one trigger is created for a cluster")
+	requester := flags.String("username", username, "user that creates triggers")
+	tokenFile := flags.String("token-file", "", "file containing bearer token required from Alertmanager")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(positional) != 0:
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional, " "))
+	case len(commaSeparated(*labels)) == 0:
+		return reportInvalidArguments("at least one cluster label needs to be specified")
+	case *tokenFile == "" && !loopbackAddress(*listen):
+		return reportInvalidArguments("token file needs to be specified when the receiver is reachable from other hosts")
+	}
+	window, err := parseAge(*dedup)
+	if err != nil {
+		return reportInvalidArguments(err.Error())
+	}
+
+	token := ""
+	if *tokenFile != "" {
+		token, err = readToken(*tokenFile)
+		if err != nil {
+			fmt.Println(colorizer.Red(CannotReadTokenFileErrorMessage))
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	// triggers are created on behalf of user
+	if *requester == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	receiver := alerts.NewReceiver(api, *requester)
+	receiver.ClusterLabels = commaSeparated(*labels)
+	receiver.AllowedAlerts = commaSeparated(*allowed)
+	receiver.DedupWindow = window
+	receiver.Token = token
+	receiver.Now = currentTime
+	receiver.Report = reportAlert
+	// cluster label needs to contain exact cluster name, because nobody
+	// checks which cluster has been selected
+	receiver.Resolve = func(value string) (string, error) {
+		return exactClusterName(api, value)
+	}
+	receiver.Duplicate = func(cluster string, since time.Time) (bool, string, error) {
+		return duplicateAlertTrigger(api, cluster, since)
+	}
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           receiver,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       alertReceiverReadTimeout,
+		WriteTimeout:      alertReceiverWriteTimeout,
+		IdleTimeout:       alertReceiverIdleTimeout,
+	}
+
+	// server is stopped by Ctrl+C
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-interrupted:
+			_ = server.Shutdown(context.Background())
+		case <-stopped:
+		}
+	}()
+
+	fmt.Println(currentTime().Format(time.RFC3339), colorizer.Blue("Receiving Alertmanager notifications on "+*listen))
+	err = server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Println(colorizer.Red(CannotStartServerErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+	fmt.Println(currentTime().Format(time.RFC3339), colorizer.Blue("Receiver stopped"))
+	return nil
+}
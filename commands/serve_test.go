@@ -0,0 +1,185 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking the command that starts receiver of Alertmanager
+// notifications.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/serve_test.html
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+)
+
+// TestServeAlertReceiverInvalidArguments function checks that invalid
+// arguments are refused before the server is started.
+func TestServeAlertReceiverInvalidArguments(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	invalid := [][]string{
+		{"unexpected"},
+		{"--dedup", "often"},
+		{"--cluster-label", ","},
+		{"--listen", ":9099"},
+		{"--listen", "192.168.1.1:9099"},
+	}
+	for _, args := range invalid {
+		var commandErr error
+		captured, err := capture.StandardOutput(func() {
+			commandErr = commands.ServeAlertReceiver(RestAPIMock{}, "tester", args)
+		})
+
+		// check if capture was done correctly
+		checkCapturedOutput(t, captured, err)
+
+		if commandErr == nil || !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestServeAlertReceiverNotLoggedIn function checks that triggers can't be
+// created without user name.
+func TestServeAlertReceiverNotLoggedIn(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.ServeAlertReceiver(RestAPIMock{}, "", []string{})
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if commandErr == nil || !strings.Contains(captured, "Not logged in") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestServeAlertReceiverCannotListen function checks that error is reported
+// when the server can't be started.
+func TestServeAlertReceiverCannotListen(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.ServeAlertReceiver(RestAPIMock{}, "tester", []string{"--listen", "127.0.0.1:invalid"})
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if commandErr == nil || !strings.Contains(captured, commands.CannotStartServerErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestServeAlertReceiverMissingTokenFile function checks that error is
+// reported when the token file can't be read.
+func TestServeAlertReceiverMissingTokenFile(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	missing := filepath.Join(t.TempDir(), "token")
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.ServeAlertReceiver(RestAPIMock{}, "tester", []string{"--listen", ":9099", "--token-file", missing})
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if commandErr == nil || !strings.Contains(captured, commands.CannotReadTokenFileErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestLoopbackAddress function checks recognition of addresses reachable
+// from local host only.
+func TestLoopbackAddress(t *testing.T) {
+	loopback := []string{"127.0.0.1:9099", "localhost:9099", "[::1]:9099", "invalid"}
+	for _, address := range loopback {
+		if !commands.LoopbackAddress(address) {
+			t.Fatal("Address should be loopback:", address)
+		}
+	}
+	other := []string{":9099", "0.0.0.0:9099", "192.168.1.1:9099", "receiver.example.com:9099"}
+	for _, address := range other {
+		if commands.LoopbackAddress(address) {
+			t.Fatal("Address should not be loopback:", address)
+		}
+	}
+}
+
+// TestExactClusterName function checks that cluster label needs to contain
+// exact cluster name.
+func TestExactClusterName(t *testing.T) {
+	name, err := commands.ExactClusterName(RestAPIMock{}, "ffffffff-ffff-ffff-ffff-ffffffffffff")
+	if err != nil || name != "ffffffff-ffff-ffff-ffff-ffffffffffff" {
+		t.Fatal("Unexpected result:", name, err)
+	}
+
+	// numeric IDs and prefixes are not accepted
+	for _, value := range []string{"1", "ffffffff", "c8590f31"} {
+		if _, err := commands.ExactClusterName(RestAPIMock{}, value); err == nil {
+			t.Fatal("Cluster should not be found:", value)
+		}
+	}
+}
+
+// TestDuplicateAlertTrigger function checks that triggers created in
+// deduplication window and pending triggers are recognized.
+func TestDuplicateAlertTrigger(t *testing.T) {
+	const cluster = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	triggeredAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// trigger created in deduplication window
+	duplicate, message, err := commands.DuplicateAlertTrigger(RestAPIMock{}, cluster, triggeredAt.Add(-time.Hour))
+	if err != nil || !duplicate || message != "trigger 0 has been created at 2020-01-01T00:00:00" {
+		t.Fatal("Unexpected result:", duplicate, message, err)
+	}
+
+	// pending trigger is just reported by default
+	duplicate, message, err = commands.DuplicateAlertTrigger(RestAPIMock{}, cluster, triggeredAt.Add(time.Hour))
+	if err != nil || duplicate || message != "trigger 0 has not been acknowledged yet" {
+		t.Fatal("Unexpected result:", duplicate, message, err)
+	}
+
+	commands.SetRefuseDuplicateTriggers(true)
+	defer commands.SetRefuseDuplicateTriggers(false)
+	duplicate, _, err = commands.DuplicateAlertTrigger(RestAPIMock{}, cluster, triggeredAt.Add(time.Hour))
+	if err != nil || !duplicate {
+		t.Fatal("Pending trigger should be refused:", duplicate, err)
+	}
+
+	// cluster without triggers
+	duplicate, message, err = commands.DuplicateAlertTrigger(RestAPIMock{}, "c8590f31-e97e-4b85-b506-c45ce1911a12", triggeredAt.Add(-time.Hour))
+	if err != nil || duplicate || message != "" {
+		t.Fatal("Unexpected result:", duplicate, message, err)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/table.html
+
+import (
+	"fmt"
+)
+
+// tableRow represents one item displayed by list commands
+type tableRow struct {
+	// ID of the item, used to pair rows of two tables
+	ID int
+
+	// plain values of columns, used to find changes of the item
+	Fields []string
+
+	// rendered (possibly colorized) line
+	Line string
+}
+
+// table represents the output of list commands
+type table struct {
+	// kind of items, used in messages about the items
+	Kind string
+
+	Title  string
+	Header string
+
+	// names of columns that correspond to fields stored in rows
+	Columns []string
+
+	Rows []tableRow
+}
+
+// print method displays the table in the format used by list commands
+func (t table) print() {
+	fmt.Println(colorizer.Magenta(t.Title))
+	fmt.Println(t.Header)
+	for _, row := range t.Rows {
+		fmt.Println(row.Line)
+	}
+}
+
+// activeFlag function returns plain and colorized flag whether the item is
+// active
+func activeFlag(active bool) (string, fmt.Stringer) {
+	if active {
+		return conditionSet, colorizer.Green(conditionSet)
+	}
+	return "no", colorizer.Red("no")
+}
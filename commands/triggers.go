@@ -23,15 +23,35 @@ package commands
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/triggers.html
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
+	"github.com/RedHatInsights/insights-operator-cli/types"
 	"github.com/c-bata/go-prompt"
 	"github.com/logrusorgru/aurora"
 )
 
 const triggerMessage = "Trigger "
 
+// flags used to select triggers in bulk operations
+const (
+	clusterFlag  = "cluster"
+	allAckedFlag = "all-acked"
+)
+
+// triggerAcked function checks whether the trigger has been acknowledged by
+// the operator, the same check is used by notifications
+func triggerAcked(trigger types.Trigger) bool {
+	return triggers.Acked(trigger)
+}
+
 // ListOfTriggers function displays list of triggers (including must-gather
 // one) gathered via REST API call to controller service.
 func ListOfTriggers(api restapi.API) {
@@ -45,23 +65,73 @@ func ListOfTriggers(api restapi.API) {
 		return
 	}
 
-	fmt.Println(colorizer.Magenta("List of triggers for all clusters"))
-	fmt.Printf("%4s %4s %-16s    %-20s %-20s %-12s %-12s %s\n", "#", "ID", "Type", clusterUUID, "Triggered at", "Triggered by", activeTrigger, "Acked at")
+	triggersTable(triggers).print()
+}
+
+// triggersTable function prepares table with list of triggers
+func triggersTable(triggers []types.Trigger) table {
+	result := table{
+		Kind:    "trigger",
+		Title:   "List of triggers for all clusters",
+		Header:  fmt.Sprintf("%4s %4s %-16s    %-20s %-20s %-12s %-12s %s", "#", "ID", "Type", clusterUUID, "Triggered at", "Triggered by", activeTrigger, "Acked at"),
+		Columns: []string{"Type", clusterUUID, "Triggered at", "Triggered by", activeTrigger, "Acked at"},
+	}
 	for i, trigger := range triggers {
-		var active aurora.Value
-		if trigger.Active == 1 {
-			active = colorizer.Green(conditionSet)
-		} else {
-			active = colorizer.Red("no")
+		plainActive, active := activeFlag(trigger.Active == 1)
+		triggeredAt := displayedTimestamp(trigger.TriggeredAt)
+		ackedAt := displayedTimestamp(trigger.AckedAt)
+		result.Rows = append(result.Rows, tableRow{
+			ID:     trigger.ID,
+			Fields: []string{trigger.Type, trigger.Cluster, triggeredAt, trigger.TriggeredBy, plainActive, ackedAt},
+			Line:   fmt.Sprintf("%4d %4d %-16s    %-20s %-20s %-12s %-12s %s", i, trigger.ID, trigger.Type, trigger.Cluster, triggeredAt, trigger.TriggeredBy, active, ackedAt),
+		})
+	}
+	return result
+}
+
+// formatDuration function returns duration rounded to seconds, long
+// durations are displayed with days
+func formatDuration(duration time.Duration) string {
+	const day = 24 * time.Hour
+
+	duration = duration.Round(time.Second)
+	if duration < day {
+		return duration.String()
+	}
+	days := fmt.Sprintf("%dd", duration/day)
+	if rest := duration % day; rest != 0 {
+		return days + " " + rest.String()
+	}
+	return days
+}
+
+// formatTimeToAck function returns time between trigger creation and its
+// acknowledgement. For triggers that have not been acked yet, the time they
+// are pending is returned.
+func formatTimeToAck(trigger types.Trigger) string {
+	triggeredAt, err := parseTimestamp(trigger.TriggeredAt)
+	if err != nil {
+		return "unknown"
+	}
+
+	if triggerAcked(trigger) {
+		ackedAt, err := parseTimestamp(trigger.AckedAt)
+		if err != nil {
+			return "unknown"
 		}
-		triggeredAt := trigger.TriggeredAt[0:19]
-		ackedAt := trigger.AckedAt[0:19]
-		fmt.Printf("%4d %4d %-16s    %-20s %-20s %-12s %-12s %s\n", i, trigger.ID, trigger.Type, trigger.Cluster, triggeredAt, trigger.TriggeredBy, active, ackedAt)
+		return formatDuration(ackedAt.Sub(triggeredAt))
 	}
+
+	if trigger.Active != 1 {
+		return "not acked"
+	}
+	// timestamps returned by the controller service are in UTC
+	pending := currentTime().UTC().Sub(triggeredAt)
+	return "not acked yet, pending for " + formatDuration(pending)
 }
 
-// DescribeTrigger function displays additional information about selected
-// trigger.
+// DescribeTrigger function displays all information about selected trigger,
+// including its reason, link, parameters and time to ack.
 func DescribeTrigger(api restapi.API, triggerID string) {
 	// try to read trigger idintified by its ID and display error message
 	// if anything wrong happens
@@ -79,8 +149,8 @@ func DescribeTrigger(api restapi.API, triggerID string) {
 		active = colorizer.Red("no")
 	}
 
-	triggeredAt := trigger.TriggeredAt[0:19]
-	ackedAt := trigger.AckedAt[0:19]
+	triggeredAt := displayedTimestamp(trigger.TriggeredAt)
+	ackedAt := displayedTimestamp(trigger.AckedAt)
 
 	var ttype aurora.Value
 	if trigger.Type == "must-gather" {
@@ -93,10 +163,35 @@ func DescribeTrigger(api restapi.API, triggerID string) {
 	fmt.Printf("ID:            %d\n", trigger.ID)
 	fmt.Printf("Type:          %s\n", ttype)
 	fmt.Printf("Cluster:       %s\n", trigger.Cluster)
+	fmt.Printf("Reason:        %s\n", trigger.Reason)
+	fmt.Printf("Link:          %s\n", formatLink(trigger.Link))
 	fmt.Printf("Triggered at:  %s\n", triggeredAt)
 	fmt.Printf("Triggered by:  %s\n", trigger.TriggeredBy)
 	fmt.Printf("Active:        %s\n", active)
 	fmt.Printf("Acked at:      %s\n", ackedAt)
+	fmt.Printf("Time to ack:   %s\n", formatTimeToAck(*trigger))
+	printTriggerParameters(trigger.Parameters)
+}
+
+// printTriggerParameters function displays trigger parameters as
+// pretty-printed JSON
+func printTriggerParameters(encoded string) {
+	if strings.TrimSpace(encoded) == "" {
+		fmt.Println("Parameters:    none")
+		return
+	}
+
+	document, err := normalizeJSON([]byte(encoded))
+	if err != nil {
+		// parameters are displayed as they are
+		fmt.Printf("Parameters:    %s\n", encoded)
+		return
+	}
+
+	fmt.Println("Parameters:")
+	for _, line := range splitLines(formatNormalizedJSON(document)) {
+		fmt.Println("    " + line)
+	}
 }
 
 // AddTrigger function adds new trigger for a cluster.
@@ -107,15 +202,259 @@ func AddTrigger(api restapi.API, username string) {
 	}
 
 	clusterName := prompt.Input("cluster name: ", LoginCompleter)
+
+	// cluster can be specified by its name, ID, or prefix of its name
+	cluster, ok := resolveClusterOrReport(api, clusterName)
+	if !ok {
+		return
+	}
+
 	reason := prompt.Input(reasonPrompt, LoginCompleter)
 	link := prompt.Input("link: ", LoginCompleter)
 
-	AddTriggerImpl(api, username, clusterName, reason, link)
+	AddTriggerImpl(api, username, cluster.Name, reason, link)
+}
+
+// readTriggerParameters function reads trigger parameters specified as
+// key=value pairs or stored in JSON file and validates them
+func readTriggerParameters(triggerType *triggers.Type, pairs []string, fileName string) ([]byte, error) {
+	var parameters triggers.Parameters
+	var err error
+
+	switch {
+	case fileName != "" && len(pairs) > 0:
+		return nil, fmt.Errorf("parameters can be specified either by --param or by --params-file flag")
+	case fileName != "":
+		var content []byte
+		content, err = os.ReadFile(fileName) // #nosec G304
+		if err != nil {
+			return nil, err
+		}
+		parameters, err = triggerType.ReadParameters(content)
+	default:
+		parameters, err = triggerType.ParseParameters(pairs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parameters.Encode()
+}
+
+// AddTypedTrigger function adds new trigger of selected type for a cluster.
+// Parameters are specified by --param key=value flags or read from JSON file
+// specified by --params-file flag and they are validated against the
+// registry of trigger types. With --wait flag the command blocks until the
+// trigger is acknowledged by the operator. Triggers for more clusters can
+// be created at once when clusters are selected by --clusters flag or by
+// --all flag.
+func AddTypedTrigger(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	return addTypedTrigger(api, username, "add trigger", args, askForConfirmation)
+}
+
+// RequestMustGather function requests must-gather for a cluster. It accepts
+// the same flags as AddTypedTrigger function.
+func RequestMustGather(api restapi.API, username string, args []string, askForConfirmation bool) error {
+	return addTypedTrigger(api, username, "request must-gather", args, askForConfirmation)
+}
+
+// triggerFlags structure contains flags shared by commands that create
+// triggers
+type triggerFlags struct {
+	triggerType string
+	reason      string
+	link        string
+	paramsFile  string
+	params      stringList
+}
+
+// addTriggerFlags function registers flags shared by commands that create
+// triggers
+func addTriggerFlags(flags *flag.FlagSet) *triggerFlags {
+	var options triggerFlags
+	flags.StringVar(&options.triggerType, "type", triggers.MustGather, "type of trigger")
+	flags.StringVar(&options.reason, "reason", "", "reason of the trigger")
+	flags.StringVar(&options.link, "link", "", "link with further information")
+	flags.StringVar(&options.paramsFile, "params-file", "", "JSON file with trigger parameters")
+	flags.Var(&options.params, "param", "trigger parameter in key=value form, can be used more times")
+	return &options
+}
+
+// typeAndParameters method returns selected trigger type and its validated
+// parameters, errors are displayed
+func (options *triggerFlags) typeAndParameters() (*triggers.Type, []byte, error) {
+	triggerType, err := triggers.Lookup(options.triggerType)
+	if err != nil {
+		return nil, nil, reportInvalidArguments(err.Error())
+	}
+	parameters, err := readTriggerParameters(triggerType, options.params, options.paramsFile)
+	if err != nil {
+		return nil, nil, reportInvalidArguments(err.Error())
+	}
+	return triggerType, parameters, nil
+}
+
+// addTypedTrigger function implements commands that add trigger of selected
+// type for a cluster or for more clusters
+func addTypedTrigger(api restapi.API, username, command string, args []string, askForConfirmation bool) error {
+	flags := newFlagSet(command)
+	options := addTriggerFlags(flags)
+	wait := flags.Bool("wait", false, "wait until the trigger is acknowledged")
+	timeout := flags.Duration("timeout", 30*time.Minute, "maximum time to wait for acknowledgement")
+	selector := flags.String("clusters", "", "clusters selected by pattern, list of clusters or @file")
+	all := flags.Bool("all", false, "create trigger for all clusters")
+	force := flags.Bool("force", false, "create trigger even if the cluster has pending trigger of the same type")
+	bulk := addBulkFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	fleet := *selector != "" || *all
+	switch {
+	case *selector != "" && *all:
+		return reportInvalidArguments("--clusters and --all flags can not be combined")
+	case fleet && len(positional) != 0:
+		return reportInvalidArguments("cluster can not be combined with --clusters or --all flag")
+	case fleet && *wait:
+		return reportInvalidArguments("--wait flag can be used for one cluster only")
+	case !fleet && len(positional) != 1:
+		return reportInvalidArguments("exactly one cluster needs to be specified")
+	}
+
+	triggerType, parameters, err := options.typeAndParameters()
+	if err != nil {
+		return err
+	}
+
+	// check if user is already loged in
+	if username == "" {
+		fmt.Println(colorizer.Red(notLoggedIn))
+		return errors.New(notLoggedIn)
+	}
+
+	if fleet {
+		return addFleetTriggers(api, username, triggerType, parameters, options, *selector, *bulk, *force, askForConfirmation)
+	}
+
+	// cluster can be specified by its name, ID, or prefix of its name
+	cluster, ok := resolveClusterOrReport(api, positional[0])
+	if !ok {
+		return errors.New(CannotResolveClusterErrorMessage)
+	}
+
+	err = checkDuplicateTrigger(api, cluster.Name, triggerType.Name, *force)
+	if err != nil {
+		return err
+	}
+
+	if options.reason == "" {
+		options.reason = prompt.Input(reasonPrompt, LoginCompleter)
+	}
+
+	// triggers known before the new one is created, needed to identify
+	// the new trigger
+	var known map[int]bool
+	if *wait {
+		list, err := api.ReadListOfTriggers()
+		if err != nil {
+			fmt.Println(colorizer.Red(ErrorReadingListOfTriggers))
+			fmt.Println(err)
+			return err
+		}
+		known = triggerIDs(list)
+	}
+
+	// try to add a new trigger and display error message if anything wrong
+	// happens
+	err = api.AddTypedTrigger(username, cluster.Name, triggerType.Name, options.reason, options.link, parameters)
+	if err != nil {
+		fmt.Println(colorizer.Red(ErrorCommunicationWithServiceErrorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// everything's ok, trigger has been created
+	fmt.Println(colorizer.Blue(triggerMessage + triggerType.Name + " has been created"))
+	if !*wait {
+		return nil
+	}
+
+	list, err := api.ReadListOfTriggers()
+	if err == nil {
+		var triggerID int
+		triggerID, err = identifyNewTrigger(known, list, cluster.Name, triggerType.Name, username)
+		if err == nil {
+			return waitForTrigger(api, triggerID, *timeout)
+		}
+	}
+	fmt.Println(colorizer.Red(CannotIdentifyTriggerErrorMessage))
+	fmt.Println(err)
+	return err
+}
+
+// refuseDuplicateTriggers is set when new trigger must not be created for
+// cluster that already has pending trigger of the same type
+var refuseDuplicateTriggers = false
+
+// SetRefuseDuplicateTriggers function sets whether new trigger is refused
+// when the cluster already has active trigger of the same type that has not
+// been acknowledged yet. Such trigger is just reported by default.
+func SetRefuseDuplicateTriggers(refuse bool) {
+	refuseDuplicateTriggers = refuse
+}
+
+// pendingTrigger function returns active trigger of given type for the
+// cluster that has not been acknowledged yet, nil is returned when there's
+// no such trigger
+func pendingTrigger(list []types.Trigger, clusterName, triggerType string) *types.Trigger {
+	for i := range list {
+		trigger := &list[i]
+		if trigger.Cluster == clusterName && trigger.Type == triggerType &&
+			trigger.Active == 1 && !triggerAcked(*trigger) {
+			return trigger
+		}
+	}
+	return nil
+}
+
+// checkDuplicateTrigger function warns user when the cluster already has
+// pending trigger of the same type. Error is returned when the new trigger
+// is to be refused.
+func checkDuplicateTrigger(api restapi.API, clusterName, triggerType string, force bool) error {
+	refuse := refuseDuplicateTriggers && !force
+
+	list, err := api.ReadListOfTriggers()
+	if err != nil {
+		// without the list it is not possible to check for duplicates
+		if !refuse {
+			return nil
+		}
+		fmt.Println(colorizer.Red(ErrorReadingListOfTriggers))
+		fmt.Println(err)
+		return err
+	}
+
+	trigger := pendingTrigger(list, clusterName, triggerType)
+	if trigger == nil {
+		return nil
+	}
+	fmt.Println(colorizer.Yellow(fmt.Sprintf("Warning: trigger %d of type %s requested by %s at %s has not been acknowledged yet",
+		trigger.ID, trigger.Type, trigger.TriggeredBy, trigger.TriggeredAt)))
+	if !refuse {
+		return nil
+	}
+	fmt.Println(colorizer.Red(DuplicateTriggerErrorMessage))
+	return errors.New(DuplicateTriggerErrorMessage)
 }
 
 // AddTriggerImpl function calls REST API to add a new trigger into the
-// database.
+// database. User is warned when the cluster already has pending must-gather
+// trigger.
 func AddTriggerImpl(api restapi.API, username, clusterName, reason, link string) {
+	if checkDuplicateTrigger(api, clusterName, triggers.MustGather, false) != nil {
+		return
+	}
+
 	// try to add a new trigger and display error message if anything wrong
 	// happens
 	err := api.AddTrigger(username, clusterName, reason, link)
@@ -173,3 +512,107 @@ func DeactivateTrigger(api restapi.API, triggerID string) {
 	// everything's ok, trigger has been deactivated
 	fmt.Println(colorizer.Blue(triggerMessage+triggerID+hasBeenMessage), colorizer.Green("deactivated"))
 }
+
+// triggerSelection represents selectors that can be used to choose triggers
+// for bulk operations
+type triggerSelection struct {
+	cluster  *string
+	allAcked *bool
+}
+
+// addTriggerSelectionFlags function registers flags used to select triggers
+func addTriggerSelectionFlags(flags *flag.FlagSet) triggerSelection {
+	return triggerSelection{
+		cluster:  flags.String(clusterFlag, "", "select all triggers for given cluster"),
+		allAcked: flags.Bool(allAckedFlag, false, "select all acknowledged triggers"),
+	}
+}
+
+// used method checks whether any selector has been specified by user
+func (selection triggerSelection) used() bool {
+	return *selection.cluster != "" || *selection.allAcked
+}
+
+// selector method returns function that reads list of triggers and selects
+// IDs of triggers matching the selection and the applicable predicate
+func (selection triggerSelection) selector(api restapi.API, applicable func(types.Trigger) bool) func() ([]string, error) {
+	return func() ([]string, error) {
+		cluster, err := resolveClusterName(api, *selection.cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		triggers, err := api.ReadListOfTriggers()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrorReadingListOfTriggers, err)
+		}
+
+		ids := []string{}
+		for _, trigger := range triggers {
+			if cluster != "" && trigger.Cluster != cluster {
+				continue
+			}
+			if *selection.allAcked && !triggerAcked(trigger) {
+				continue
+			}
+			if applicable(trigger) {
+				ids = append(ids, strconv.Itoa(trigger.ID))
+			}
+		}
+		return ids, nil
+	}
+}
+
+// bulkTriggerCommand function parses arguments of bulk trigger command and
+// runs it for all selected triggers
+func bulkTriggerCommand(api restapi.API, name string, args []string, command bulkCommand,
+	applicable func(types.Trigger) bool, askForConfirmation bool) error {
+	flags := newFlagSet(name)
+	selection := addTriggerSelectionFlags(flags)
+	options := addBulkFlags(flags)
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+
+	return runBulkCommand(command, positional, selection.used(),
+		selection.selector(api, applicable), *options, askForConfirmation)
+}
+
+// DeleteTriggers function deletes all triggers specified by list of IDs (for
+// example "3,5,9-20") or selected by --cluster or --all-acked flags.
+func DeleteTriggers(api restapi.API, args []string, askForConfirmation bool) error {
+	command := bulkCommand{
+		subject:   triggerMessage,
+		done:      colorizer.Red(deleted),
+		question:  "%d triggers will be deleted: %s",
+		operation: api.DeleteTrigger,
+	}
+	all := func(types.Trigger) bool { return true }
+	return bulkTriggerCommand(api, "delete trigger", args, command, all, askForConfirmation)
+}
+
+// ActivateTriggers function activates all triggers specified by list of IDs
+// or selected by --cluster or --all-acked flags.
+func ActivateTriggers(api restapi.API, args []string) error {
+	command := bulkCommand{
+		subject:   triggerMessage,
+		done:      colorizer.Green("activated"),
+		operation: api.ActivateTrigger,
+	}
+	inactive := func(trigger types.Trigger) bool { return trigger.Active != 1 }
+	return bulkTriggerCommand(api, "activate trigger", args, command, inactive, false)
+}
+
+// DeactivateTriggers function deactivates all triggers specified by list of
+// IDs or selected by --cluster or --all-acked flags.
+func DeactivateTriggers(api restapi.API, args []string) error {
+	command := bulkCommand{
+		subject:   triggerMessage,
+		done:      colorizer.Green("deactivated"),
+		operation: api.DeactivateTrigger,
+	}
+	active := func(trigger types.Trigger) bool { return trigger.Active == 1 }
+	return bulkTriggerCommand(api, "deactivate trigger", args, command, active, false)
+}
@@ -22,13 +22,17 @@ package commands_test
 // https://redhatinsights.github.io/insights-operator-cli/packages/commands/commands_test.html
 
 import (
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/tisnik/go-capture"
 
 	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
 )
 
 // tryToFindTrigger is a helper function that tries to find a trigger ID in
@@ -496,3 +500,397 @@ func TestDeactivateTriggerErrorHandling(t *testing.T) {
 		t.Fatal("Unexpected output:\n", captured)
 	}
 }
+
+// describeTrigger is a helper function that displays trigger selected by its
+// ID and returns the captured output
+func describeTrigger(t *testing.T, triggerID string) string {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		commands.DescribeTrigger(RestAPIMock{}, triggerID)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured
+}
+
+// TestDescribeTriggerParameters function checks that trigger parameters are
+// displayed as pretty-printed JSON.
+func TestDescribeTriggerParameters(t *testing.T) {
+	captured := describeTrigger(t, "3")
+
+	expected := `Parameters:
+    {
+        "gatherers": [
+            "clusterconfig",
+            "workloads"
+        ],
+        "upload": true
+    }
+`
+	if !strings.HasSuffix(captured, expected) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeTriggerDetails function checks that reason, link and time to
+// ack are displayed.
+func TestDescribeTriggerDetails(t *testing.T) {
+	captured := describeTrigger(t, "1")
+
+	expected := []string{
+		"Reason:        we need to run must-gather",
+		"Link:          https://www.webpagetest.org/",
+		"Time to ack:   1d",
+	}
+	for _, line := range expected {
+		if !strings.Contains(captured, line+"\n") {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+	}
+}
+
+// TestDescribePendingTrigger function checks that time for which active
+// trigger is pending is displayed.
+func TestDescribePendingTrigger(t *testing.T) {
+	defer commands.SetCurrentTime(time.Date(2020, 1, 3, 2, 30, 5, 0, time.UTC))()
+
+	captured := describeTrigger(t, "3")
+	if !strings.Contains(captured, "Time to ack:   not acked yet, pending for 2d 2h30m5s\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeTriggerHyperlink function checks that link is displayed as
+// terminal hyperlink when hyperlinks are enabled.
+func TestDescribeTriggerHyperlink(t *testing.T) {
+	commands.SetHyperlinks(true)
+	defer commands.SetHyperlinks(false)
+
+	captured := describeTrigger(t, "0")
+	expected := "Link:          \x1b]8;;https://www.webpagetest.org/\x1b\\https://www.webpagetest.org/\x1b]8;;\x1b\\\n"
+	if !strings.Contains(captured, expected) {
+		t.Fatalf("Unexpected output:\n%q", captured)
+	}
+}
+
+// TestFormatLink function checks that links with control characters are
+// never sent to terminal as they are.
+func TestFormatLink(t *testing.T) {
+	commands.SetHyperlinks(true)
+	defer commands.SetHyperlinks(false)
+
+	if commands.FormatLink("") != "none" {
+		t.Fatal("Empty link should be displayed as none")
+	}
+	formatted := commands.FormatLink("https://example.com/\x1b]0;title\x07")
+	if formatted != `"https://example.com/\x1b]0;title\a"` {
+		t.Fatalf("Link with control characters should be quoted: %q", formatted)
+	}
+}
+
+// TestTerminalSupportsHyperlinks function checks detection of terminals
+// that support hyperlinks.
+func TestTerminalSupportsHyperlinks(t *testing.T) {
+	environments := []struct {
+		variables map[string]string
+		supported bool
+	}{
+		{map[string]string{}, false},
+		{map[string]string{"TERM": "xterm-256color"}, false},
+		{map[string]string{"TERM_PROGRAM": "iTerm.app"}, true},
+		{map[string]string{"VTE_VERSION": "6003"}, true},
+		{map[string]string{"VTE_VERSION": "4205"}, false},
+		{map[string]string{"WT_SESSION": "1"}, true},
+		{map[string]string{"TERM": "xterm-kitty"}, true},
+	}
+	for _, environment := range environments {
+		getenv := func(name string) string {
+			return environment.variables[name]
+		}
+		if commands.TerminalSupportsHyperlinks(getenv) != environment.supported {
+			t.Error("Unexpected detection for", environment.variables)
+		}
+	}
+}
+
+// TestDescribeTriggerWithoutParameters function checks output for trigger
+// without parameters.
+func TestDescribeTriggerWithoutParameters(t *testing.T) {
+	captured := describeTrigger(t, "0")
+	if !strings.Contains(captured, "Parameters:    none") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeTriggerRawParameters function checks that parameters that are
+// not JSON object are displayed as they are.
+func TestDescribeTriggerRawParameters(t *testing.T) {
+	captured := describeTrigger(t, "2")
+	if !strings.Contains(captured, "Parameters:    -a -W") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// typedTriggerMock is an implementation of mocked REST API that records
+// created triggers
+type typedTriggerMock struct {
+	RestAPIMock
+	calls *[]string
+}
+
+// AddTypedTrigger records the call
+func (api typedTriggerMock) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	*api.calls = append(*api.calls, strings.Join([]string{username, clusterName, triggerType, reason, link, string(parameters)}, " "))
+	return nil
+}
+
+// useTriggerTypes function configures trigger type with parameters like the
+// user would do in configuration file, the returned function restores the
+// built-in trigger types
+func useTriggerTypes(t *testing.T) func() {
+	err := triggers.Configure([]triggers.Type{{
+		Name:        "custom-gather",
+		Description: "custom data gathering",
+		Parameters: []triggers.Parameter{
+			{Name: "gatherers", Kind: triggers.ListParameter},
+			{Name: "upload", Kind: triggers.BoolParameter},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		_ = triggers.Configure(nil)
+	}
+}
+
+// runAddTypedTrigger is a helper function that runs add trigger command and
+// returns its output, recorded calls and error
+func runAddTypedTrigger(t *testing.T, username string, args []string) (string, []string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer useTriggerTypes(t)()
+
+	api := typedTriggerMock{calls: &[]string{}}
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.AddTypedTrigger(api, username, args, false)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, *api.calls, commandErr
+}
+
+// TestAddTypedTrigger function checks adding trigger with parameters
+// specified on command line.
+func TestAddTypedTrigger(t *testing.T) {
+	captured, calls, err := runAddTypedTrigger(t, "tester", []string{
+		"00000000", "--type", "custom-gather", "--param", "gatherers=a,b",
+		"--param", "upload=1", "--reason", "missing data", "--link", "https://example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "Trigger custom-gather has been created") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	expected := `tester 00000000-0000-0000-0000-000000000000 custom-gather missing data https://example.com {"gatherers":["a","b"],"upload":true}`
+	if len(calls) != 1 || calls[0] != expected {
+		t.Fatal("Unexpected calls:", calls)
+	}
+}
+
+// TestAddTypedTriggerParamsFile function checks adding trigger with
+// parameters read from file.
+func TestAddTypedTriggerParamsFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "params.json")
+	err := os.WriteFile(fileName, []byte(`{"upload": true}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, calls, err := runAddTypedTrigger(t, "tester", []string{
+		"00000000-0000-0000-0000-000000000000", "--type", "custom-gather", "--params-file", fileName, "--reason", "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `tester 00000000-0000-0000-0000-000000000000 custom-gather r  {"upload":true}`
+	if len(calls) != 1 || calls[0] != expected {
+		t.Fatal("Unexpected calls:", calls)
+	}
+}
+
+// TestAddTypedTriggerInvalidArguments function checks that invalid trigger
+// type and parameters are refused before the trigger is created.
+func TestAddTypedTriggerInvalidArguments(t *testing.T) {
+	invalid := [][]string{
+		{},
+		{"00000000", "--type", "unknown"},
+		{"00000000", "--param", "unknown=1"},
+		{"00000000", "--param", "upload=1", "--params-file", "params.json"},
+		{"00000000", "--param", "timeout=1h"},
+		{"00000000", "--params-file", "/does/not/exist.json"},
+	}
+	for _, args := range invalid {
+		captured, calls, err := runAddTypedTrigger(t, "tester", append(args, "--reason", "r"))
+		if err == nil {
+			t.Fatal("Error is expected to be returned for", args)
+		}
+		if !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Unexpected output:\n", captured)
+		}
+		if len(calls) != 0 {
+			t.Fatal("Trigger should not be created:", calls)
+		}
+	}
+}
+
+// TestAddTypedTriggerNotLoggedIn function checks that user needs to be
+// logged in.
+func TestAddTypedTriggerNotLoggedIn(t *testing.T) {
+	captured, _, err := runAddTypedTrigger(t, "", []string{"00000000", "--reason", "r"})
+	if err == nil || !strings.Contains(captured, "Not logged in") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAddTypedTriggerError function checks how REST API errors are
+// reported.
+func TestAddTypedTriggerError(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	captured, err := capture.StandardOutput(func() {
+		err := commands.AddTypedTrigger(RestAPIMockErrors{}, "tester", []string{"00000000", "--reason", "r"}, false)
+		if err == nil {
+			t.Fatal("Error is expected to be returned")
+		}
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, commands.ErrorReadingListOfClusters) &&
+		!strings.Contains(captured, commands.ErrorCommunicationWithServiceErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAddTypedTriggerDuplicateWarning function checks that pending trigger
+// of the same type is reported, but the new trigger is created.
+func TestAddTypedTriggerDuplicateWarning(t *testing.T) {
+	captured, calls, err := runAddTypedTrigger(t, "tester", []string{"00000000", "--reason", "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(captured, "Warning: trigger 2 of type must-gather requested by tester") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if len(calls) != 1 {
+		t.Fatal("Trigger should be created:", calls)
+	}
+}
+
+// TestAddTypedTriggerDuplicateOtherType function checks that pending
+// trigger of different type is not reported.
+func TestAddTypedTriggerDuplicateOtherType(t *testing.T) {
+	captured, _, err := runAddTypedTrigger(t, "tester", []string{"00000000", "--type", "custom-gather", "--reason", "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(captured, "Warning") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestAddTypedTriggerDuplicateRefused function checks that new trigger is
+// refused when duplicates are not allowed, unless --force flag is used.
+func TestAddTypedTriggerDuplicateRefused(t *testing.T) {
+	commands.SetRefuseDuplicateTriggers(true)
+	defer commands.SetRefuseDuplicateTriggers(false)
+
+	captured, calls, err := runAddTypedTrigger(t, "tester", []string{"00000000", "--reason", "r"})
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if !strings.Contains(captured, commands.DuplicateTriggerErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+	if len(calls) != 0 {
+		t.Fatal("Trigger should not be created:", calls)
+	}
+
+	_, calls, err = runAddTypedTrigger(t, "tester", []string{"00000000", "--reason", "r", "--force"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 {
+		t.Fatal("Trigger should be created:", calls)
+	}
+}
+
+// TestAddTriggerImplDuplicateRefused function checks that new trigger is
+// not created for cluster with pending trigger when duplicates are not
+// allowed.
+func TestAddTriggerImplDuplicateRefused(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	commands.SetRefuseDuplicateTriggers(true)
+	defer commands.SetRefuseDuplicateTriggers(false)
+
+	captured, err := capture.StandardOutput(func() {
+		commands.AddTriggerImpl(RestAPIMock{}, "tester", "ffffffff-ffff-ffff-ffff-ffffffffffff", "reason", "link")
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "Warning: trigger 0 of type must-gather") ||
+		!strings.Contains(captured, commands.DuplicateTriggerErrorMessage) ||
+		strings.Contains(captured, "Trigger has been created") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestListOfTriggersShortTimestamps checks that the command 'list triggers'
+// displays timestamps that can't be parsed as they are
+func TestListOfTriggersShortTimestamps(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		commands.ListOfTriggers(shortTimestampsMock{})
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "1970") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestDescribeTriggerShortTimestamps checks that the command 'describe trigger'
+// displays timestamps that can't be parsed as they are
+func TestDescribeTriggerShortTimestamps(t *testing.T) {
+	// turn off any colorization on standard output
+	configureColorizer()
+
+	// use go-capture package to capture all writes to standard output
+	captured, err := capture.StandardOutput(func() {
+		commands.DescribeTrigger(shortTimestampsMock{}, "0")
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+
+	if !strings.Contains(captured, "Acked at:      1970") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
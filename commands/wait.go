@@ -0,0 +1,137 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/wait.html
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// intervals used when waiting for trigger to be acknowledged, the controller
+// is polled less often as the time goes
+const (
+	initialPollInterval = time.Second
+	maximalPollInterval = 30 * time.Second
+	spinnerInterval     = 250 * time.Millisecond
+)
+
+// spinnerFrames contains frames of spinner displayed while waiting
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// sleep pauses the current goroutine, it is replaced in unit tests
+var sleep = time.Sleep
+
+// triggerIDs function returns set of IDs of all triggers from the list
+func triggerIDs(list []types.Trigger) map[int]bool {
+	ids := make(map[int]bool, len(list))
+	for _, trigger := range list {
+		ids[trigger.ID] = true
+	}
+	return ids
+}
+
+// identifyNewTrigger function finds trigger that has been created by the
+// user for given cluster, ie. trigger that is not in the list of known
+// triggers. REST API does not return ID of created trigger, so the newest
+// matching trigger is selected.
+func identifyNewTrigger(known map[int]bool, list []types.Trigger, cluster, triggerType, username string) (int, error) {
+	found := -1
+	for _, trigger := range list {
+		if known[trigger.ID] || trigger.Cluster != cluster ||
+			trigger.Type != triggerType || trigger.TriggeredBy != username {
+			continue
+		}
+		if trigger.ID > found {
+			found = trigger.ID
+		}
+	}
+	if found < 0 {
+		return 0, fmt.Errorf("trigger %s for cluster %s not found", triggerType, cluster)
+	}
+	return found, nil
+}
+
+// clearSpinner function removes the spinner line from terminal
+func clearSpinner() {
+	fmt.Print("\r\x1b[K")
+}
+
+// waitForTrigger function polls the controller until the trigger is
+// acknowledged by the operator. Spinner with elapsed time is displayed in the
+// meantime. Timeout and deactivation of the trigger are reported by errors
+// with specific exit status.
+func waitForTrigger(api restapi.API, triggerID int, timeout time.Duration) error {
+	id := strconv.Itoa(triggerID)
+	started := currentTime()
+	nextPoll := started
+	interval := initialPollInterval
+
+	for frame := 0; ; frame++ {
+		now := currentTime()
+		elapsed := now.Sub(started)
+
+		if !now.Before(nextPoll) {
+			trigger, err := api.ReadTriggerByID(id)
+			if err != nil {
+				clearSpinner()
+				fmt.Println(colorizer.Red(ErrorReadingSelectedTrigger))
+				fmt.Println(err)
+				return err
+			}
+			if triggerAcked(*trigger) {
+				clearSpinner()
+				fmt.Println(colorizer.Green(triggerMessage + id + " has been acknowledged after " + formatTimeToAck(*trigger)))
+				return nil
+			}
+			if trigger.Active != 1 {
+				clearSpinner()
+				message := triggerMessage + id + " has been deactivated before it was acknowledged"
+				fmt.Println(colorizer.Red(message))
+				return &StatusError{Status: ExitStatusDeactivated, Err: errors.New(message)}
+			}
+
+			// backoff
+			nextPoll = now.Add(interval)
+			interval *= 2
+			if interval > maximalPollInterval {
+				interval = maximalPollInterval
+			}
+		}
+
+		if elapsed >= timeout {
+			clearSpinner()
+			message := triggerMessage + id + " has not been acknowledged in " + formatDuration(timeout)
+			fmt.Println(colorizer.Red(message))
+			return &StatusError{Status: ExitStatusTimeout, Err: errors.New(message)}
+		}
+
+		fmt.Printf("\r%s waiting for trigger %s to be acknowledged, elapsed %s ",
+			spinnerFrames[frame%len(spinnerFrames)], id, formatDuration(elapsed))
+		sleep(spinnerInterval)
+	}
+}
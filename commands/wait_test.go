@@ -0,0 +1,212 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking waiting for acknowledgement of created triggers.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/wait_test.html
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// newTriggerID is ID of trigger created by waitMock
+const newTriggerID = 42
+
+// waitMock is an implementation of mocked REST API that creates trigger
+// which is acknowledged or deactivated after selected number of polls
+type waitMock struct {
+	RestAPIMock
+	created         *bool
+	polls           *int
+	ackAfter        int
+	deactivateAfter int
+	listed          bool
+}
+
+// newWaitMock function constructs mocked REST API, negative number of polls
+// means that the trigger is never acknowledged or deactivated
+func newWaitMock(ackAfter, deactivateAfter int) waitMock {
+	return waitMock{
+		created:         new(bool),
+		polls:           new(int),
+		ackAfter:        ackAfter,
+		deactivateAfter: deactivateAfter,
+		listed:          true,
+	}
+}
+
+// newTrigger returns state of created trigger
+func (api waitMock) newTrigger() types.Trigger {
+	trigger := types.Trigger{
+		ID:          newTriggerID,
+		Type:        "must-gather",
+		Cluster:     "00000000-0000-0000-0000-000000000000",
+		Reason:      "reason",
+		TriggeredAt: "2020-01-01T00:00:00",
+		TriggeredBy: "tester",
+		AckedAt:     "1970-01-01T00:00:00",
+		Active:      1,
+	}
+	if api.ackAfter >= 0 && *api.polls > api.ackAfter {
+		trigger.AckedAt = "2020-01-01T00:05:00"
+	}
+	if api.deactivateAfter >= 0 && *api.polls > api.deactivateAfter {
+		trigger.Active = 0
+	}
+	return trigger
+}
+
+// ReadListOfTriggers returns mocked triggers and the created one
+func (api waitMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	list, err := api.RestAPIMock.ReadListOfTriggers()
+	if *api.created && api.listed {
+		list = append(list, api.newTrigger())
+	}
+	return list, err
+}
+
+// AddTypedTrigger records that the trigger has been created
+func (api waitMock) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	*api.created = true
+	return nil
+}
+
+// ReadTriggerByID returns current state of created trigger
+func (api waitMock) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	*api.polls++
+	trigger := api.newTrigger()
+	return &trigger, nil
+}
+
+// runRequestMustGather is a helper function that runs request must-gather
+// command with fake clock and returns its output and error
+func runRequestMustGather(t *testing.T, api waitMock, args []string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.UseFakeClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.RequestMustGather(api, "tester", args, false)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// TestRequestMustGatherWait function checks that the command waits until the
+// trigger is acknowledged.
+func TestRequestMustGatherWait(t *testing.T) {
+	api := newWaitMock(3, -1)
+	captured, err := runRequestMustGather(t, api, []string{"00000000", "--reason", "reason", "--wait"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *api.polls != 4 {
+		t.Fatal("Unexpected number of polls:", *api.polls)
+	}
+	if !strings.Contains(captured, "waiting for trigger 42 to be acknowledged, elapsed 3s") {
+		t.Fatal("Elapsed time is not displayed:\n", captured)
+	}
+	if !strings.HasSuffix(captured, "Trigger 42 has been acknowledged after 5m0s\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestRequestMustGatherWithoutWait function checks that the command does not
+// poll the controller without --wait flag.
+func TestRequestMustGatherWithoutWait(t *testing.T) {
+	api := newWaitMock(0, -1)
+	captured, err := runRequestMustGather(t, api, []string{"00000000", "--reason", "reason"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *api.polls != 0 {
+		t.Fatal("Controller should not be polled")
+	}
+	expected := "Warning: trigger 2 of type must-gather requested by tester at 2020-01-01T00:00:00 has not been acknowledged yet\n" +
+		"Trigger must-gather has been created\n"
+	if captured != expected {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestRequestMustGatherTimeout function checks that timeout is reported by
+// specific exit status and that the polling interval grows.
+func TestRequestMustGatherTimeout(t *testing.T) {
+	api := newWaitMock(-1, -1)
+	captured, err := runRequestMustGather(t, api, []string{
+		"00000000", "--reason", "reason", "--wait", "--timeout", "1m"})
+	if commands.ExitStatus(err) != commands.ExitStatusTimeout {
+		t.Fatal("Unexpected error:", err)
+	}
+	// polls at 0s, 1s, 3s, 7s, 15s and 31s
+	if *api.polls != 6 {
+		t.Fatal("Unexpected number of polls:", *api.polls)
+	}
+	if !strings.HasSuffix(captured, "Trigger 42 has not been acknowledged in 1m0s\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestRequestMustGatherDeactivated function checks that deactivation of
+// trigger is reported by specific exit status.
+func TestRequestMustGatherDeactivated(t *testing.T) {
+	api := newWaitMock(-1, 1)
+	captured, err := runRequestMustGather(t, api, []string{"00000000", "--reason", "reason", "--wait"})
+	if commands.ExitStatus(err) != commands.ExitStatusDeactivated {
+		t.Fatal("Unexpected error:", err)
+	}
+	if !strings.HasSuffix(captured, "Trigger 42 has been deactivated before it was acknowledged\n") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestRequestMustGatherNotIdentified function checks the situation when the
+// created trigger can not be found.
+func TestRequestMustGatherNotIdentified(t *testing.T) {
+	api := newWaitMock(0, -1)
+	api.listed = false
+	captured, err := runRequestMustGather(t, api, []string{"00000000", "--reason", "reason", "--wait"})
+	if commands.ExitStatus(err) != commands.ExitStatusFailure {
+		t.Fatal("Unexpected error:", err)
+	}
+	if !strings.Contains(captured, commands.CannotIdentifyTriggerErrorMessage) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestExitStatus function checks exit statuses of command results.
+func TestExitStatus(t *testing.T) {
+	if commands.ExitStatus(nil) != 0 {
+		t.Fatal("Success should be reported by zero exit status")
+	}
+	if commands.ExitStatus(errors.New("error")) != commands.ExitStatusFailure {
+		t.Fatal("Generic failure should be reported by exit status 1")
+	}
+}
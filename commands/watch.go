@@ -0,0 +1,278 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/commands
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/watch.html
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// maximal number of change log lines displayed below the watched table
+const watchLogSize = 20
+
+// kinds of changes found between two refreshes of watched table
+const (
+	rowAdded   = "added"
+	rowRemoved = "removed"
+	rowChanged = "changed"
+)
+
+// after waits for the duration to elapse, it is replaced in unit tests
+var after = time.After
+
+// watchedList represents list command that can be watched
+type watchedList struct {
+	errorMessage string
+	filtered     bool
+	read         func(api restapi.API, filter string) (table, notifications.Snapshot, error)
+}
+
+// watchedLists contains all list commands that can be watched, state of
+// triggers and configurations is returned as well to detect their state
+// changes
+var watchedLists = map[string]watchedList{
+	"list clusters": {ErrorReadingListOfClusters, false,
+		func(api restapi.API, _ string) (table, notifications.Snapshot, error) {
+			clusters, err := api.ReadListOfClusters()
+			return clustersTable(clusters), notifications.Snapshot{}, err
+		}},
+	"list profiles": {ErrorReadingListOfConfigurationProfiles, false,
+		func(api restapi.API, _ string) (table, notifications.Snapshot, error) {
+			profiles, err := api.ReadListOfConfigurationProfiles()
+			return profilesTable(profiles), notifications.Snapshot{}, err
+		}},
+	"list configurations": {ErrorReadingListOfConfigurations, true,
+		func(api restapi.API, filter string) (table, notifications.Snapshot, error) {
+			configurations, err := api.ReadListOfConfigurations()
+			return configurationsTable(configurations, filter), configurationsSnapshot(configurations), err
+		}},
+	"list triggers": {ErrorReadingListOfTriggers, false,
+		func(api restapi.API, _ string) (table, notifications.Snapshot, error) {
+			triggers, err := api.ReadListOfTriggers()
+			return triggersTable(triggers), triggersSnapshot(triggers), err
+		}},
+	"list must-gather": {ErrorReadingListOfTriggers, false,
+		func(api restapi.API, _ string) (table, notifications.Snapshot, error) {
+			triggers, err := api.ReadListOfTriggers()
+			return triggersTable(triggers), triggersSnapshot(triggers), err
+		}},
+}
+
+// rowChange represents change of one item between two refreshes
+type rowChange struct {
+	Kind    string
+	Row     tableRow
+	Details []string
+}
+
+// compareTables function finds rows that were added, removed or changed
+func compareTables(previous, current table) []rowChange {
+	previousRows := make(map[int]tableRow, len(previous.Rows))
+	for _, row := range previous.Rows {
+		previousRows[row.ID] = row
+	}
+	currentIDs := make(map[int]bool, len(current.Rows))
+
+	changes := []rowChange{}
+	for _, row := range current.Rows {
+		currentIDs[row.ID] = true
+		old, found := previousRows[row.ID]
+		if !found {
+			changes = append(changes, rowChange{Kind: rowAdded, Row: row})
+			continue
+		}
+		details := []string{}
+		for i, column := range current.Columns {
+			if old.Fields[i] != row.Fields[i] {
+				details = append(details, fmt.Sprintf("%s %s -> %s", column, old.Fields[i], row.Fields[i]))
+			}
+		}
+		if len(details) > 0 {
+			changes = append(changes, rowChange{Kind: rowChanged, Row: row, Details: details})
+		}
+	}
+	for _, row := range previous.Rows {
+		if !currentIDs[row.ID] {
+			changes = append(changes, rowChange{Kind: rowRemoved, Row: row})
+		}
+	}
+	return changes
+}
+
+// changeLogLine function returns change log line describing the change
+func changeLogLine(kind string, change rowChange) string {
+	line := fmt.Sprintf("%s %s %d %s", currentTime().Format("15:04:05"), kind, change.Row.ID, change.Kind)
+	if len(change.Details) > 0 {
+		line += ": " + strings.Join(change.Details, ", ")
+	}
+	return line
+}
+
+// printWatchedTable function displays the table with highlighted rows that
+// were added or changed since the previous refresh, removed rows are
+// displayed at the end of table
+func printWatchedTable(current table, changes []rowChange) {
+	kinds := make(map[int]string, len(changes))
+	for _, change := range changes {
+		kinds[change.Row.ID] = change.Kind
+	}
+
+	fmt.Println(colorizer.Magenta(current.Title))
+	fmt.Println("  " + current.Header)
+	for _, row := range current.Rows {
+		switch kinds[row.ID] {
+		case rowAdded:
+			fmt.Println(colorizer.Green("+"), row.Line)
+		case rowChanged:
+			fmt.Println(colorizer.Yellow("~"), row.Line)
+		default:
+			fmt.Println(" ", row.Line)
+		}
+	}
+	for _, change := range changes {
+		if change.Kind == rowRemoved {
+			fmt.Println(colorizer.Red("-"), colorizer.Gray(12, change.Row.Line))
+		}
+	}
+}
+
+// Watch function periodically runs the selected list command and displays
+// its output. Rows that were added, removed or changed since the previous
+// refresh are highlighted and each change is recorded in change log. On
+// terminal, the output is re-rendered in place.
+func Watch(api restapi.API, args []string) error {
+	flags := newFlagSet("watch")
+	interval := flags.Duration("interval", 5*time.Second, "time between refreshes")
+	count := flags.Int("count", 0, "number of refreshes, zero means until interrupted")
+
+	positional, err := parseCommandArguments(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return reportInvalidArguments("list command needs to be specified")
+	}
+	command := strings.Join(positional[:2], " ")
+	list, found := watchedLists[command]
+	if !found {
+		return reportInvalidArguments("command can not be watched: " + command + " (supported: " + watchedCommands() + ")")
+	}
+	if len(positional) > 2 && !list.filtered {
+		return reportInvalidArguments("unexpected arguments: " + strings.Join(positional[2:], " "))
+	}
+	if *interval <= 0 {
+		return reportInvalidArguments("interval needs to be positive")
+	}
+	filter := strings.Join(positional[2:], " ")
+
+	current, snapshot, err := list.read(api, filter)
+	if err != nil {
+		fmt.Println(colorizer.Red(list.errorMessage))
+		fmt.Println(err)
+		return err
+	}
+
+	// watching is finished by Ctrl+C
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	inPlace := stdoutIsTerminal()
+	changeLog := []string{}
+	changes := []rowChange{}
+
+	for refresh := 1; ; refresh++ {
+		newLines := []string{}
+		if refresh > 1 {
+			refreshed, refreshedSnapshot, err := list.read(api, filter)
+			if err != nil {
+				newLines = append(newLines, fmt.Sprintf("%s %s: %v",
+					currentTime().Format("15:04:05"), list.errorMessage, err))
+				changes = []rowChange{}
+			} else {
+				changes = compareTables(current, refreshed)
+				for _, change := range changes {
+					newLines = append(newLines, changeLogLine(current.Kind, change))
+				}
+				if _, err := notifyStateChanges(snapshot, refreshedSnapshot); err != nil {
+					newLines = append(newLines, fmt.Sprintf("%s %s: %v",
+						currentTime().Format("15:04:05"), CannotSendNotificationsErrorMessage, err))
+				}
+				current, snapshot = refreshed, refreshedSnapshot
+			}
+		}
+		changeLog = append(changeLog, newLines...)
+		if len(changeLog) > watchLogSize {
+			changeLog = changeLog[len(changeLog)-watchLogSize:]
+		}
+
+		if inPlace {
+			// move cursor home and clear the screen
+			fmt.Print("\x1b[H\x1b[2J")
+		}
+		fmt.Println(colorizer.Blue(fmt.Sprintf("Every %s: %s", interval, strings.Join(positional, " "))),
+			currentTime().Format(time.RFC1123))
+		printWatchedTable(current, changes)
+
+		// on terminal the whole (recent) change log is displayed, as
+		// the previous output has been cleared
+		if inPlace {
+			newLines = changeLog
+		}
+		if len(newLines) > 0 {
+			fmt.Println()
+			for _, line := range newLines {
+				fmt.Println(line)
+			}
+		}
+		fmt.Println()
+
+		if *count > 0 && refresh >= *count {
+			flushNotifications("15:04:05")
+			return nil
+		}
+		select {
+		case <-interrupted:
+			flushNotifications("15:04:05")
+			return nil
+		case <-after(*interval):
+		}
+	}
+}
+
+// watchedCommands function returns list of commands that can be watched
+func watchedCommands() string {
+	commands := make([]string, 0, len(watchedLists))
+	for command := range watchedLists {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return strings.Join(commands, ", ")
+}
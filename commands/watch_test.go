@@ -0,0 +1,155 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+// Unit tests checking watch mode of list commands.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/commands/watch_test.html
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tisnik/go-capture"
+
+	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// watchMock is an implementation of mocked REST API that returns different
+// list of triggers on each call
+type watchMock struct {
+	RestAPIMock
+	calls *int
+}
+
+// ReadListOfTriggers returns mocked triggers, on the second call trigger 0 is
+// acked, trigger 1 is removed and a new trigger is created, the third call
+// fails
+func (api watchMock) ReadListOfTriggers() ([]types.Trigger, error) {
+	*api.calls++
+	list, _ := api.RestAPIMock.ReadListOfTriggers()
+	switch *api.calls {
+	case 1:
+		return list, nil
+	case 2:
+		list[0].AckedAt = "2020-01-02T00:00:00"
+		list[0].Active = 0
+		list = append(list[:1], list[2:]...)
+		return append(list, types.Trigger{
+			ID:          42,
+			Type:        "must-gather",
+			Cluster:     "00000000-0000-0000-0000-000000000000",
+			TriggeredAt: "2020-01-03T00:00:00",
+			TriggeredBy: "tester",
+			AckedAt:     "1970-01-01T00:00:00",
+			Active:      1,
+		}), nil
+	default:
+		return nil, errors.New("connection refused")
+	}
+}
+
+// runWatch is a helper function that runs watch command with fake clock and
+// returns its output and error
+func runWatch(t *testing.T, api restapi.API, args []string) (string, error) {
+	// turn off any colorization on standard output
+	configureColorizer()
+	defer commands.UseFakeClock(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC))()
+
+	var commandErr error
+	captured, err := capture.StandardOutput(func() {
+		commandErr = commands.Watch(api, args)
+	})
+
+	// check if capture was done correctly
+	checkCapturedOutput(t, captured, err)
+	return captured, commandErr
+}
+
+// TestWatchTriggers function checks that changes in watched list are
+// highlighted and recorded in change log.
+func TestWatchTriggers(t *testing.T) {
+	api := watchMock{calls: new(int)}
+	captured, err := runWatch(t, api, []string{"list", "triggers", "--interval", "10s", "--count", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *api.calls != 3 {
+		t.Fatal("Unexpected number of refreshes:", *api.calls)
+	}
+
+	expected := []string{
+		"Every 10s: list triggers Wed, 01 Jan 2020 10:00:00 UTC\n",
+		"Every 10s: list triggers Wed, 01 Jan 2020 10:00:10 UTC\n",
+		"~    0    0 must-gather",
+		"+    3   42 must-gather",
+		"-    1    1 must-gather",
+		"10:00:10 trigger 0 changed: Active yes -> no, Acked at 1970-01-01T00:00:00 -> 2020-01-02T00:00:00\n",
+		"10:00:10 trigger 42 added\n",
+		"10:00:10 trigger 1 removed\n",
+		"10:00:20 Error reading list of triggers: connection refused\n",
+	}
+	for _, line := range expected {
+		if !strings.Contains(captured, line) {
+			t.Fatalf("Expected %q in output:\n%s", line, captured)
+		}
+	}
+}
+
+// TestWatchConfigurationsFilter function checks that list of configurations
+// can be filtered.
+func TestWatchConfigurationsFilter(t *testing.T) {
+	captured, err := runWatch(t, RestAPIMock{}, []string{"list", "configurations", "ffffffff", "--count", "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(captured, "Every 5s: list configurations ffffffff") ||
+		strings.Contains(captured, "00000000-0000-0000-0000-000000000000") {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
+
+// TestWatchUnsupportedCommand function checks that only list commands can be
+// watched.
+func TestWatchUnsupportedCommand(t *testing.T) {
+	invalid := [][]string{
+		{},
+		{"delete", "cluster"},
+		{"list", "triggers", "foo"},
+		{"list", "triggers", "--interval", "0s"},
+	}
+	for _, args := range invalid {
+		captured, err := runWatch(t, RestAPIMock{}, args)
+		if err == nil || !strings.Contains(captured, commands.InvalidCommandArgumentsErrorMessage) {
+			t.Fatal("Invalid arguments should be reported:", args, captured)
+		}
+	}
+}
+
+// TestWatchError function checks that error is reported when the list can
+// not be read at all.
+func TestWatchError(t *testing.T) {
+	captured, err := runWatch(t, RestAPIMockErrors{}, []string{"list", "clusters"})
+	if err == nil || !strings.Contains(captured, commands.ErrorReadingListOfClusters) {
+		t.Fatal("Unexpected output:\n", captured)
+	}
+}
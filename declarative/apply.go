@@ -0,0 +1,98 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package declarative
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/declarative
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/apply.html
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// Apply function executes all actions from plan in order. The execution
+// stops on the first failed action; done callback is called after each
+// successful action.
+func Apply(api restapi.API, plan *Plan, username string, done func(Action)) error {
+	for _, action := range plan.Actions {
+		err := applyAction(api, action, username)
+		if err != nil {
+			return fmt.Errorf("%s: %v", action, err)
+		}
+		if done != nil {
+			done(action)
+		}
+	}
+	return nil
+}
+
+// applyAction function executes one action via REST API
+func applyAction(api restapi.API, action Action, username string) error {
+	switch action.Kind {
+	case CreateCluster:
+		return api.AddCluster(action.Cluster)
+	case CreateProfile:
+		return api.AddConfigurationProfile(username, action.Profile, action.Payload)
+	case CreateConfiguration:
+		return createConfiguration(api, action, username)
+	case EnableConfiguration:
+		return api.EnableClusterConfiguration(strconv.Itoa(action.ID))
+	case DisableConfiguration:
+		return api.DisableClusterConfiguration(strconv.Itoa(action.ID))
+	case DeleteCluster:
+		return api.DeleteCluster(strconv.Itoa(action.ID))
+	default:
+		return fmt.Errorf("unknown action %s", action.Kind)
+	}
+}
+
+// createConfiguration function creates new configuration for cluster and
+// makes sure it is enabled. Other active configurations are disabled by
+// separate actions.
+func createConfiguration(api restapi.API, action Action, username string) error {
+	err := api.AddClusterConfiguration(username, action.Cluster, action.Reason, action.Profile, action.Payload)
+	if err != nil {
+		return err
+	}
+
+	// REST API does not return ID of new configuration, so the newest
+	// configuration of cluster is used
+	configurations, err := api.ReadListOfConfigurations()
+	if err != nil {
+		return err
+	}
+	newest := -1
+	active := false
+	for _, configuration := range configurations {
+		if configuration.Cluster == action.Cluster && configuration.ID > newest {
+			newest = configuration.ID
+			active = configuration.Active == "1"
+		}
+	}
+	if newest < 0 {
+		return fmt.Errorf("new configuration for cluster %s not found", action.Cluster)
+	}
+	if active {
+		return nil
+	}
+	return api.EnableClusterConfiguration(strconv.Itoa(newest))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package declarative contains implementation of declarative management of
+// controller state. Desired clusters, configuration profiles and active
+// configurations of clusters are described in a manifest (YAML or JSON
+// file). Plan of actions needed to reach the desired state is computed
+// against the live state read via REST API and it can be applied using the
+// same REST API.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * apply.go
+//
+// * declarative.go
+//
+// * manifest.go
+//
+// * plan.go
+package declarative
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/declarative
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/declarative.html
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ActionKind represents kind of operation performed by plan
+type ActionKind string
+
+// all kinds of actions, in the order they are executed
+const (
+	CreateCluster        ActionKind = "create cluster"
+	CreateProfile        ActionKind = "create profile"
+	CreateConfiguration  ActionKind = "create configuration"
+	EnableConfiguration  ActionKind = "enable configuration"
+	DisableConfiguration ActionKind = "disable configuration"
+	DeleteCluster        ActionKind = "delete cluster"
+)
+
+// Action represents one operation that changes the controller state
+type Action struct {
+	Kind ActionKind
+
+	// name of cluster the action is performed on
+	Cluster string
+
+	// ID of existing cluster or configuration
+	ID int
+
+	// description and payload of profile to be created or used for new
+	// configuration
+	Profile string
+	Payload []byte
+
+	// reason of new configuration
+	Reason string
+}
+
+// Plan represents list of actions needed to reach the desired state
+type Plan struct {
+	Actions []Action
+}
+
+// Empty method returns true when the live state matches desired state
+func (plan *Plan) Empty() bool {
+	return len(plan.Actions) == 0
+}
+
+// Count method returns number of actions of given kinds
+func (plan *Plan) Count(kinds ...ActionKind) int {
+	count := 0
+	for _, action := range plan.Actions {
+		for _, kind := range kinds {
+			if action.Kind == kind {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// normalizePayload function returns JSON document in canonical form (sorted
+// keys, no whitespaces) so documents can be compared. Documents that are not
+// valid JSON are compared as they are.
+func normalizePayload(payload []byte) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return string(payload)
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return string(payload)
+	}
+	return string(normalized)
+}
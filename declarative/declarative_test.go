@@ -0,0 +1,243 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package declarative_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/declarative_test.html
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/RedHatInsights/insights-operator-cli/declarative"
+)
+
+// sampleManifest is manifest used by most tests, profile "default" is stored
+// in separate file
+const sampleManifest = `
+clusters:
+  - cluster-a
+  - cluster-b
+profiles:
+  - description: default
+    file: default.json
+  - description: no-op
+    configuration:
+      no_op: X
+      watch: []
+configurations:
+  - cluster: cluster-a
+    profile: default
+  - cluster: cluster-b
+    profile: no-op
+    reason: quiet cluster
+`
+
+// writeManifest function stores manifest and payload of default profile
+// into temporary directory
+func writeManifest(t *testing.T, manifest string) string {
+	directory := t.TempDir()
+	err := os.WriteFile(filepath.Join(directory, "default.json"), []byte(`{"watch": ["a"]}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileName := filepath.Join(directory, "manifest.yaml")
+	err = os.WriteFile(fileName, []byte(manifest), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+// loadManifest function reads manifest written into temporary directory
+func loadManifest(t *testing.T, manifest string) *declarative.Manifest {
+	loaded, err := declarative.LoadManifest(writeManifest(t, manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loaded
+}
+
+// planActions function returns textual form of all actions from plan
+func planActions(t *testing.T, api *memoryAPI, manifest *declarative.Manifest, prune bool) []string {
+	plan, err := declarative.ComputePlan(api, manifest, prune)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := []string{}
+	for _, action := range plan.Actions {
+		actions = append(actions, action.String())
+	}
+	return actions
+}
+
+// checkActions function compares actions with the expected ones
+func checkActions(t *testing.T, actions, expected []string) {
+	if strings.Join(actions, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("Unexpected actions:\n%s\nexpected:\n%s",
+			strings.Join(actions, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+// TestLoadManifestJSON checks that manifest can be written in JSON
+func TestLoadManifestJSON(t *testing.T) {
+	manifest := loadManifest(t, `{
+		"clusters": ["cluster-a"],
+		"profiles": [{"description": "inline", "configuration": "{\"no_op\": \"X\"}"}],
+		"configurations": [{"cluster": "cluster-a", "profile": "inline"}]
+	}`)
+	if len(manifest.Clusters) != 1 || len(manifest.Profiles) != 1 || len(manifest.Configurations) != 1 {
+		t.Fatal("Manifest is not read properly", manifest)
+	}
+}
+
+// TestLoadManifestInvalid checks that inconsistent manifests are refused
+func TestLoadManifestInvalid(t *testing.T) {
+	manifests := map[string]string{
+		"unknown key":         "clusterz: []",
+		"duplicate cluster":   "clusters: [a, a]",
+		"missing payload":     "profiles: [{description: p}]",
+		"both payloads":       "profiles: [{description: p, file: default.json, configuration: {}}]",
+		"missing file":        "profiles: [{description: p, file: missing.json}]",
+		"invalid JSON":        "profiles: [{description: p, configuration: 'not json'}]",
+		"duplicate profile":   "profiles: [{description: p, file: default.json}, {description: p, file: default.json}]",
+		"unknown cluster":     "profiles: [{description: p, file: default.json}]\nconfigurations: [{cluster: a, profile: p}]",
+		"unknown profile":     "clusters: [a]\nconfigurations: [{cluster: a, profile: p}]",
+		"two configurations":  "clusters: [a]\nprofiles: [{description: p, file: default.json}]\nconfigurations: [{cluster: a, profile: p}, {cluster: a, profile: p}]",
+		"empty cluster name":  "clusters: ['']",
+		"empty description":   "profiles: [{file: default.json}]",
+		"not a YAML document": "clusters: [",
+	}
+	for name, manifest := range manifests {
+		_, err := declarative.LoadManifest(writeManifest(t, manifest))
+		if err == nil {
+			t.Error("Manifest should be refused:", name)
+		}
+	}
+}
+
+// TestPlanEmptyController checks that everything is created in empty
+// controller
+func TestPlanEmptyController(t *testing.T) {
+	actions := planActions(t, newMemoryAPI(1), loadManifest(t, sampleManifest), false)
+	checkActions(t, actions, []string{
+		"create cluster cluster-a",
+		"create cluster cluster-b",
+		`create profile "default"`,
+		`create profile "no-op"`,
+		`create configuration for cluster cluster-a (profile "default")`,
+		`create configuration for cluster cluster-b (profile "no-op")`,
+	})
+}
+
+// TestApplyThenPlan checks that nothing needs to be done after the plan is
+// applied
+func TestApplyThenPlan(t *testing.T) {
+	api := newMemoryAPI(1)
+	manifest := loadManifest(t, sampleManifest)
+
+	plan, err := declarative.ComputePlan(api, manifest, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	performed := 0
+	err = declarative.Apply(api, plan, "tester", func(declarative.Action) {
+		performed++
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if performed != 6 {
+		t.Fatal("All actions should be performed", performed)
+	}
+	if api.configurations[1].Reason != "quiet cluster" || api.configurations[0].Reason != "Applied from manifest" {
+		t.Fatal("Unexpected reasons of configurations", api.configurations)
+	}
+
+	checkActions(t, planActions(t, api, manifest, false), []string{})
+}
+
+// TestPlanActivation checks that existing configuration with the desired
+// payload is enabled and other active configurations are disabled
+func TestPlanActivation(t *testing.T) {
+	api := newMemoryAPI(1)
+	for _, step := range []error{
+		api.AddCluster("cluster-a"),
+		api.AddCluster("cluster-b"),
+		api.AddConfigurationProfile("tester", "default", []byte(`{"watch":["a"]}`)),
+		api.AddConfigurationProfile("tester", "no-op", []byte(`{"watch":[],"no_op":"X"}`)),
+		// configuration 6 has the desired payload, but it is not active
+		api.AddClusterConfiguration("tester", "cluster-a", "r", "default", []byte(`{"watch":["a"]}`)),
+		api.AddClusterConfiguration("tester", "cluster-a", "r", "other", []byte(`{"watch":["b"]}`)),
+		api.DisableClusterConfiguration("6"),
+		// two active configurations, the second one is the desired one
+		api.AddClusterConfiguration("tester", "cluster-b", "r", "other", []byte(`{}`)),
+		api.AddClusterConfiguration("tester", "cluster-b", "r", "no-op", []byte(`{"no_op":"X","watch":[]}`)),
+	} {
+		if step != nil {
+			t.Fatal(step)
+		}
+	}
+
+	actions := planActions(t, api, loadManifest(t, sampleManifest), false)
+	checkActions(t, actions, []string{
+		"enable configuration 6 for cluster cluster-a",
+		"disable configuration 8 for cluster cluster-a",
+		"disable configuration 10 for cluster cluster-b",
+	})
+}
+
+// TestPlanPrune checks that clusters not specified in manifest are deleted
+// only when prune is requested
+func TestPlanPrune(t *testing.T) {
+	api := newMemoryAPI(1)
+	if err := api.AddCluster("cluster-x"); err != nil {
+		t.Fatal(err)
+	}
+	manifest := loadManifest(t, "clusters: [cluster-a]")
+
+	checkActions(t, planActions(t, api, manifest, false), []string{
+		"create cluster cluster-a",
+	})
+	checkActions(t, planActions(t, api, manifest, true), []string{
+		"create cluster cluster-a",
+		"delete cluster cluster-x (ID 1) with all its configurations",
+	})
+}
+
+// TestApplyStopsOnError checks that apply stops on the first failed action
+func TestApplyStopsOnError(t *testing.T) {
+	api := newMemoryAPI(1)
+	plan, err := declarative.ComputePlan(api, loadManifest(t, sampleManifest), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api.err = os.ErrPermission
+	performed := 0
+	err = declarative.Apply(api, plan, "tester", func(declarative.Action) {
+		performed++
+	})
+	if err == nil || !strings.Contains(err.Error(), "create cluster cluster-a") {
+		t.Fatal("Error of the first action is expected", err)
+	}
+	if performed != 0 {
+		t.Fatal("No action should be performed", performed)
+	}
+}
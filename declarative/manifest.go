@@ -0,0 +1,218 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package declarative
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/declarative
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/manifest.html
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ProfileSpec represents desired configuration profile. Its payload is
+// either stored in separate file (path is relative to manifest) or it is
+// part of manifest.
+type ProfileSpec struct {
+	Description   string      `yaml:"description"`
+	File          string      `yaml:"file"`
+	Configuration interface{} `yaml:"configuration"`
+
+	// payload read from file or converted from manifest
+	payload []byte
+}
+
+// ConfigurationSpec represents desired active configuration of cluster
+type ConfigurationSpec struct {
+	Cluster string `yaml:"cluster"`
+	Profile string `yaml:"profile"`
+	Reason  string `yaml:"reason"`
+}
+
+// Manifest represents desired state of controller
+type Manifest struct {
+	Clusters       []string            `yaml:"clusters"`
+	Profiles       []ProfileSpec       `yaml:"profiles"`
+	Configurations []ConfigurationSpec `yaml:"configurations"`
+}
+
+// LoadManifest function reads manifest from YAML or JSON file, reads
+// payloads of all profiles and checks that the manifest is consistent
+func LoadManifest(fileName string) (*Manifest, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is subset of YAML, so both formats are handled the same way
+	var manifest Manifest
+	err = yaml.UnmarshalStrict(content, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %v", fileName, err)
+	}
+
+	err = manifest.loadPayloads(filepath.Dir(fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	err = manifest.validate()
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// profile method returns desired profile with given description
+func (manifest *Manifest) profile(description string) *ProfileSpec {
+	for i := range manifest.Profiles {
+		if manifest.Profiles[i].Description == description {
+			return &manifest.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// loadPayloads method reads payloads of all profiles. Payloads stored in
+// files are read from directory relative to manifest.
+func (manifest *Manifest) loadPayloads(directory string) error {
+	for i := range manifest.Profiles {
+		profile := &manifest.Profiles[i]
+		switch {
+		case profile.File != "" && profile.Configuration != nil:
+			return fmt.Errorf("profile %q: only one of file and configuration can be specified", profile.Description)
+		case profile.File != "":
+			fileName := profile.File
+			if !filepath.IsAbs(fileName) {
+				fileName = filepath.Join(directory, fileName)
+			}
+			payload, err := os.ReadFile(fileName)
+			if err != nil {
+				return fmt.Errorf("profile %q: %v", profile.Description, err)
+			}
+			profile.payload = payload
+		case profile.Configuration != nil:
+			payload, err := payloadFromManifest(profile.Configuration)
+			if err != nil {
+				return fmt.Errorf("profile %q: %v", profile.Description, err)
+			}
+			profile.payload = payload
+		default:
+			return fmt.Errorf("profile %q: file or configuration needs to be specified", profile.Description)
+		}
+		if !json.Valid(profile.payload) {
+			return fmt.Errorf("profile %q: configuration is not valid JSON", profile.Description)
+		}
+	}
+	return nil
+}
+
+// payloadFromManifest function converts configuration written directly in
+// manifest into JSON. Configuration can be written as a string with JSON
+// document or as YAML/JSON object.
+func payloadFromManifest(configuration interface{}) ([]byte, error) {
+	if text, ok := configuration.(string); ok {
+		return []byte(text), nil
+	}
+	value, err := jsonCompatible(configuration)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(value, "", "    ")
+}
+
+// jsonCompatible function converts maps decoded from YAML (with keys of
+// any type) into maps that can be encoded into JSON
+func jsonCompatible(value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, item := range typed {
+			name, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("key %v is not a string", key)
+			}
+			convertedItem, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[name] = convertedItem
+		}
+		return converted, nil
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, item := range typed {
+			convertedItem, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = convertedItem
+		}
+		return converted, nil
+	default:
+		return value, nil
+	}
+}
+
+// validate method checks that manifest is consistent: all names are unique
+// and configurations refer to clusters and profiles from the manifest
+func (manifest *Manifest) validate() error {
+	clusters := map[string]bool{}
+	for _, cluster := range manifest.Clusters {
+		if cluster == "" {
+			return fmt.Errorf("cluster name can not be empty")
+		}
+		if clusters[cluster] {
+			return fmt.Errorf("cluster %s is specified more than once", cluster)
+		}
+		clusters[cluster] = true
+	}
+
+	profiles := map[string]bool{}
+	for _, profile := range manifest.Profiles {
+		if profile.Description == "" {
+			return fmt.Errorf("profile description can not be empty")
+		}
+		if profiles[profile.Description] {
+			return fmt.Errorf("profile %q is specified more than once", profile.Description)
+		}
+		profiles[profile.Description] = true
+	}
+
+	configured := map[string]bool{}
+	for _, configuration := range manifest.Configurations {
+		if !clusters[configuration.Cluster] {
+			return fmt.Errorf("configuration refers to cluster %s that is not in list of clusters", configuration.Cluster)
+		}
+		if !profiles[configuration.Profile] {
+			return fmt.Errorf("configuration for cluster %s refers to unknown profile %q",
+				configuration.Cluster, configuration.Profile)
+		}
+		if configured[configuration.Cluster] {
+			return fmt.Errorf("cluster %s has more than one configuration", configuration.Cluster)
+		}
+		configured[configuration.Cluster] = true
+	}
+	return nil
+}
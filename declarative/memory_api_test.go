@@ -0,0 +1,282 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package declarative_test
+
+// In-memory implementation of REST API used by unit tests. It behaves like
+// the controller service: new objects get new IDs and configuration is
+// created together with its own configuration profile.
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/memory_api_test.html
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// errNotFound is returned when object with given ID does not exist
+var errNotFound = errors.New("not found")
+
+// memoryAPI structure is an in-memory implementation of REST API
+type memoryAPI struct {
+	clusters       []types.Cluster
+	profiles       []types.ConfigurationProfile
+	configurations []types.ClusterConfiguration
+	payloads       map[int]string
+	triggers       []types.Trigger
+	lastID         int
+
+	// error to be returned from all mutating methods
+	err error
+}
+
+// newMemoryAPI function constructs new empty in-memory REST API. IDs of
+// objects start from the given value so they differ between instances.
+func newMemoryAPI(firstID int) *memoryAPI {
+	return &memoryAPI{
+		payloads: map[int]string{},
+		lastID:   firstID - 1,
+	}
+}
+
+// nextID method returns ID for new object
+func (api *memoryAPI) nextID() int {
+	api.lastID++
+	return api.lastID
+}
+
+// ReadListOfClusters returns list of clusters
+func (api *memoryAPI) ReadListOfClusters() ([]types.Cluster, error) {
+	return append([]types.Cluster{}, api.clusters...), nil
+}
+
+// AddCluster adds new cluster
+func (api *memoryAPI) AddCluster(name string) error {
+	if api.err != nil {
+		return api.err
+	}
+	api.clusters = append(api.clusters, types.Cluster{ID: api.nextID(), Name: name})
+	return nil
+}
+
+// DeleteCluster deletes cluster together with its configurations
+func (api *memoryAPI) DeleteCluster(clusterID string) error {
+	if api.err != nil {
+		return api.err
+	}
+	for i, cluster := range api.clusters {
+		if strconv.Itoa(cluster.ID) != clusterID {
+			continue
+		}
+		api.clusters = append(api.clusters[:i], api.clusters[i+1:]...)
+		configurations := []types.ClusterConfiguration{}
+		for _, configuration := range api.configurations {
+			if configuration.Cluster != cluster.Name {
+				configurations = append(configurations, configuration)
+			}
+		}
+		api.configurations = configurations
+		return nil
+	}
+	return errNotFound
+}
+
+// ReadListOfConfigurationProfiles returns profiles without payloads, the
+// same as the controller service does
+func (api *memoryAPI) ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error) {
+	profiles := []types.ConfigurationProfile{}
+	for _, profile := range api.profiles {
+		profile.Configuration = ""
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// ReadConfigurationProfile returns profile with its payload
+func (api *memoryAPI) ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error) {
+	for _, profile := range api.profiles {
+		if strconv.Itoa(profile.ID) == profileID {
+			return &profile, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+// addProfile method creates new profile and returns its ID
+func (api *memoryAPI) addProfile(username, description string, configuration []byte) int {
+	profile := types.ConfigurationProfile{
+		ID:            api.nextID(),
+		Configuration: string(configuration),
+		ChangedAt:     "2026-01-01T00:00:00Z",
+		ChangedBy:     username,
+		Description:   description,
+	}
+	api.profiles = append(api.profiles, profile)
+	return profile.ID
+}
+
+// AddConfigurationProfile adds new profile
+func (api *memoryAPI) AddConfigurationProfile(username, description string, configuration []byte) error {
+	if api.err != nil {
+		return api.err
+	}
+	api.addProfile(username, description, configuration)
+	return nil
+}
+
+// UpdateConfigurationProfile is not used by declarative package
+func (api *memoryAPI) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	return errors.New("not implemented")
+}
+
+// DeleteConfigurationProfile is not used by declarative package
+func (api *memoryAPI) DeleteConfigurationProfile(profileID string) error {
+	return errors.New("not implemented")
+}
+
+// ReadListOfConfigurations returns list of configurations
+func (api *memoryAPI) ReadListOfConfigurations() ([]types.ClusterConfiguration, error) {
+	return append([]types.ClusterConfiguration{}, api.configurations...), nil
+}
+
+// ReadClusterConfigurationByID returns payload of configuration
+func (api *memoryAPI) ReadClusterConfigurationByID(configurationID string) (*string, error) {
+	id, err := strconv.Atoi(configurationID)
+	if err != nil {
+		return nil, err
+	}
+	payload, found := api.payloads[id]
+	if !found {
+		return nil, errNotFound
+	}
+	return &payload, nil
+}
+
+// AddClusterConfiguration adds new active configuration together with new
+// profile
+func (api *memoryAPI) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
+	if api.err != nil {
+		return api.err
+	}
+	profileID := api.addProfile(username, description, configuration)
+	id := api.nextID()
+	api.configurations = append(api.configurations, types.ClusterConfiguration{
+		ID:            id,
+		Cluster:       cluster,
+		Configuration: strconv.Itoa(profileID),
+		ChangedAt:     "2026-01-01T00:00:00Z",
+		ChangedBy:     username,
+		Active:        "1",
+		Reason:        reason,
+	})
+	api.payloads[id] = string(configuration)
+	return nil
+}
+
+// setConfigurationActive method changes active flag of configuration
+func (api *memoryAPI) setConfigurationActive(configurationID, active string) error {
+	if api.err != nil {
+		return api.err
+	}
+	for i := range api.configurations {
+		if strconv.Itoa(api.configurations[i].ID) == configurationID {
+			api.configurations[i].Active = active
+			return nil
+		}
+	}
+	return errNotFound
+}
+
+// EnableClusterConfiguration enables configuration
+func (api *memoryAPI) EnableClusterConfiguration(configurationID string) error {
+	return api.setConfigurationActive(configurationID, "1")
+}
+
+// DisableClusterConfiguration disables configuration
+func (api *memoryAPI) DisableClusterConfiguration(configurationID string) error {
+	return api.setConfigurationActive(configurationID, "0")
+}
+
+// DeleteClusterConfiguration is not used by declarative package
+func (api *memoryAPI) DeleteClusterConfiguration(configurationID string) error {
+	return errors.New("not implemented")
+}
+
+// ReadListOfTriggers returns list of triggers
+func (api *memoryAPI) ReadListOfTriggers() ([]types.Trigger, error) {
+	return append([]types.Trigger{}, api.triggers...), nil
+}
+
+// ReadTriggerByID is not used by declarative package
+func (api *memoryAPI) ReadTriggerByID(triggerID string) (*types.Trigger, error) {
+	return nil, errors.New("not implemented")
+}
+
+// AddTrigger adds new active must-gather trigger
+func (api *memoryAPI) AddTrigger(username, clusterName, reason, link string) error {
+	return api.AddTypedTrigger(username, clusterName, "must-gather", reason, link, nil)
+}
+
+// AddTypedTrigger adds new active trigger of given type
+func (api *memoryAPI) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	if api.err != nil {
+		return api.err
+	}
+	api.triggers = append(api.triggers, types.Trigger{
+		ID:          api.nextID(),
+		Type:        triggerType,
+		Cluster:     clusterName,
+		Reason:      reason,
+		Link:        link,
+		TriggeredAt: "2026-01-01T00:00:00Z",
+		TriggeredBy: username,
+		Parameters:  string(parameters),
+		Active:      1,
+	})
+	return nil
+}
+
+// DeleteTrigger is not used by declarative package
+func (api *memoryAPI) DeleteTrigger(triggerID string) error {
+	return errors.New("not implemented")
+}
+
+// setTriggerActive method changes active flag of trigger
+func (api *memoryAPI) setTriggerActive(triggerID string, active int) error {
+	if api.err != nil {
+		return api.err
+	}
+	for i := range api.triggers {
+		if strconv.Itoa(api.triggers[i].ID) == triggerID {
+			api.triggers[i].Active = active
+			return nil
+		}
+	}
+	return errNotFound
+}
+
+// ActivateTrigger activates trigger
+func (api *memoryAPI) ActivateTrigger(triggerID string) error {
+	return api.setTriggerActive(triggerID, 1)
+}
+
+// DeactivateTrigger deactivates trigger
+func (api *memoryAPI) DeactivateTrigger(triggerID string) error {
+	return api.setTriggerActive(triggerID, 0)
+}
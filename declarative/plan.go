@@ -0,0 +1,201 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package declarative
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/declarative
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/declarative/plan.html
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/RedHatInsights/insights-operator-cli/backup"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// defaultReason is reason of new configurations that don't have reason
+// specified in manifest
+const defaultReason = "Applied from manifest"
+
+// actionOrder specifies the order in which actions are executed
+var actionOrder = map[ActionKind]int{
+	CreateCluster:        0,
+	CreateProfile:        1,
+	CreateConfiguration:  2,
+	EnableConfiguration:  3,
+	DisableConfiguration: 4,
+	DeleteCluster:        5,
+}
+
+// String method returns human readable description of action
+func (action Action) String() string {
+	switch action.Kind {
+	case CreateCluster:
+		return fmt.Sprintf("%s %s", action.Kind, action.Cluster)
+	case CreateProfile:
+		return fmt.Sprintf("%s %q", action.Kind, action.Profile)
+	case CreateConfiguration:
+		return fmt.Sprintf("%s for cluster %s (profile %q)", action.Kind, action.Cluster, action.Profile)
+	case EnableConfiguration, DisableConfiguration:
+		return fmt.Sprintf("%s %d for cluster %s", action.Kind, action.ID, action.Cluster)
+	case DeleteCluster:
+		return fmt.Sprintf("%s %s (ID %d) with all its configurations", action.Kind, action.Cluster, action.ID)
+	default:
+		return string(action.Kind)
+	}
+}
+
+// ComputePlan function reads live state of controller via REST API and
+// computes actions needed to reach the state described by manifest. Clusters
+// that are not part of manifest are deleted only when prune is set.
+func ComputePlan(api restapi.API, manifest *Manifest, prune bool) (*Plan, error) {
+	live, err := backup.Collect(api)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := Plan{
+		Actions: []Action{},
+	}
+	planClusters(&plan, manifest, live, prune)
+	planProfiles(&plan, manifest, live)
+	planConfigurations(&plan, manifest, live)
+
+	sort.SliceStable(plan.Actions, func(i, j int) bool {
+		return actionOrder[plan.Actions[i].Kind] < actionOrder[plan.Actions[j].Kind]
+	})
+	return &plan, nil
+}
+
+// planClusters function adds actions that create missing clusters and
+// optionally delete clusters not specified in manifest
+func planClusters(plan *Plan, manifest *Manifest, live *backup.Snapshot, prune bool) {
+	existing := map[string]bool{}
+	for _, cluster := range live.Clusters {
+		existing[cluster.Name] = true
+	}
+	desired := map[string]bool{}
+	for _, cluster := range manifest.Clusters {
+		desired[cluster] = true
+		if !existing[cluster] {
+			plan.Actions = append(plan.Actions, Action{Kind: CreateCluster, Cluster: cluster})
+		}
+	}
+
+	if !prune {
+		return
+	}
+	for _, cluster := range live.Clusters {
+		if !desired[cluster.Name] {
+			plan.Actions = append(plan.Actions, Action{Kind: DeleteCluster, Cluster: cluster.Name, ID: cluster.ID})
+		}
+	}
+}
+
+// planProfiles function adds actions that create profiles that don't exist
+// with the same description and payload. Existing profiles are never
+// changed, because configurations might be based on them.
+func planProfiles(plan *Plan, manifest *Manifest, live *backup.Snapshot) {
+	existing := map[string]bool{}
+	for _, profile := range live.Profiles {
+		existing[profile.Description+"\x00"+normalizePayload([]byte(profile.Configuration))] = true
+	}
+	for _, profile := range manifest.Profiles {
+		if !existing[profile.Description+"\x00"+normalizePayload(profile.payload)] {
+			plan.Actions = append(plan.Actions, Action{
+				Kind:    CreateProfile,
+				Profile: profile.Description,
+				Payload: profile.payload,
+			})
+		}
+	}
+}
+
+// planConfigurations function adds actions that make the desired
+// configuration the only active configuration of each cluster. Existing
+// configuration with the same payload is enabled, new configuration is
+// created otherwise.
+func planConfigurations(plan *Plan, manifest *Manifest, live *backup.Snapshot) {
+	for _, spec := range manifest.Configurations {
+		profile := manifest.profile(spec.Profile)
+		desired := normalizePayload(profile.payload)
+
+		// the newest matching configuration is preferred, but the active
+		// one is not changed
+		var target *backup.ConfigurationRecord
+		active := []backup.ConfigurationRecord{}
+		for i := range live.Configurations {
+			configuration := &live.Configurations[i]
+			if configuration.Cluster != spec.Cluster {
+				continue
+			}
+			if configuration.Active == "1" {
+				active = append(active, *configuration)
+			}
+			if normalizePayload([]byte(configuration.Payload)) != desired {
+				continue
+			}
+			if target == nil || betterTarget(configuration, target) {
+				target = configuration
+			}
+		}
+
+		switch {
+		case target == nil:
+			reason := spec.Reason
+			if reason == "" {
+				reason = defaultReason
+			}
+			plan.Actions = append(plan.Actions, Action{
+				Kind:    CreateConfiguration,
+				Cluster: spec.Cluster,
+				Profile: profile.Description,
+				Payload: profile.payload,
+				Reason:  reason,
+			})
+		case target.Active != "1":
+			plan.Actions = append(plan.Actions, Action{
+				Kind:    EnableConfiguration,
+				Cluster: spec.Cluster,
+				ID:      target.ID,
+			})
+		}
+
+		for _, configuration := range active {
+			if target == nil || configuration.ID != target.ID {
+				plan.Actions = append(plan.Actions, Action{
+					Kind:    DisableConfiguration,
+					Cluster: spec.Cluster,
+					ID:      configuration.ID,
+				})
+			}
+		}
+	}
+}
+
+// betterTarget function returns true when configuration should be activated
+// rather than the current candidate: active configuration is preferred,
+// then the newest one
+func betterTarget(configuration, candidate *backup.ConfigurationRecord) bool {
+	if (configuration.Active == "1") != (candidate.Active == "1") {
+		return configuration.Active == "1"
+	}
+	return configuration.ID > candidate.ID
+}
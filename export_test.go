@@ -39,4 +39,7 @@ var (
 	ReadConfiguration = readConfiguration
 	PrintVersion      = printVersion
 	Colorizer         = &colorizer
+
+	RefusedInOfflineMode     = refusedInOfflineMode
+	CheckAsksForConfirmation = checkAsksForConfirmation
 )
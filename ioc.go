@@ -28,14 +28,23 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"github.com/RedHatInsights/insights-operator-cli/audit"
+	"github.com/RedHatInsights/insights-operator-cli/cache"
 	"github.com/RedHatInsights/insights-operator-cli/commands"
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
 	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/schedule"
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
 	"github.com/c-bata/go-prompt"
+	"github.com/kballard/go-shellquote"
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/viper"
 	"golang.org/x/crypto/ssh/terminal"
 	"os"
+	"os/user"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 // prompts
@@ -60,6 +69,10 @@ type Configuration struct {
 
 	// enable or disable Tab-completion
 	useCompleter *bool
+
+	// serve read commands from local cache instead of calling the
+	// controller service
+	offline *bool
 }
 
 // configuration represents current CLI configuration
@@ -78,6 +91,14 @@ var api restapi.API
 // color output on terminal
 var colorizer aurora.Aurora
 
+// staleDataReported is set when the user has been already informed that the
+// data displayed by current command are read from local cache
+var staleDataReported bool
+
+// currentCommand contains command line of command being executed, it is
+// recorded in audit log
+var currentCommand string
+
 // tryToLogin tries to login to service via REST API
 func tryToLogin(username, password string) {
 	fmt.Println(colorizer.Blue("\nDone"))
@@ -121,30 +142,210 @@ var commandsWithParam = []commandWithParam{
 	{"list configurations ", commands.ListOfConfigurations},
 	{"delete cluster ", commands.DeleteClusterNoConfirm},
 	{"delete profile ", commands.DeleteConfigurationProfileNoConfirm},
-	{"delete configuration ", commands.DeleteClusterConfiguration},
-	{"delete trigger ", commands.DeleteTrigger},
-	{"activate must-gather ", commands.ActivateTrigger},
-	{"activate trigger ", commands.ActivateTrigger},
-	{"deactivate must-gather ", commands.DeactivateTrigger},
-	{"deactivate trigger ", commands.DeactivateTrigger},
 	{"add cluster ", commands.AddCluster},
 	{"new cluster ", commands.AddCluster},
 }
 
+// commandWithArgs represents commands that accept any number of arguments and
+// flags. Handler returns an error when the command has not been finished
+// successfully, all messages are displayed by handler itself.
+type commandWithArgs struct {
+	prefix  string
+	handler func(restapi.API, []string) error
+}
+
+// commandsWithArgs is a list of all supported commands with arguments and
+// flags
+var commandsWithArgs = []commandWithArgs{
+	{"delete configuration ", func(api restapi.API, args []string) error {
+		return commands.DeleteClusterConfigurations(api, args, *configuration.askForConfirmation)
+	}},
+	{"delete trigger ", func(api restapi.API, args []string) error {
+		return commands.DeleteTriggers(api, args, *configuration.askForConfirmation)
+	}},
+	{"describe cluster ", commands.DescribeCluster},
+	{"add trigger ", func(api restapi.API, args []string) error {
+		return commands.AddTypedTrigger(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"new trigger ", func(api restapi.API, args []string) error {
+		return commands.AddTypedTrigger(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"request must-gather ", func(api restapi.API, args []string) error {
+		return commands.RequestMustGather(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"diff ", commands.Diff},
+	{"history cluster ", commands.HistoryOfCluster},
+	{"clone profile ", func(api restapi.API, args []string) error {
+		return commands.CloneProfile(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"clone configuration ", func(api restapi.API, args []string) error {
+		return commands.CloneClusterConfiguration(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"check ", func(api restapi.API, args []string) error {
+		return commands.Check(api, args,
+			checkAsksForConfirmation(*configuration.askForConfirmation, *configuration.offline))
+	}},
+	{"export ", commands.Export},
+	{"plan ", commands.Plan},
+	{"drift ", commands.Drift},
+	{"watch ", commands.Watch},
+	{"schedule ", func(api restapi.API, args []string) error {
+		return commands.Schedule(api, username, args)
+	}},
+	{"cancel schedule ", func(api restapi.API, args []string) error {
+		return commands.CancelSchedule(args, *configuration.askForConfirmation)
+	}},
+	{"daemon ", commands.Daemon},
+	{"apply ", func(api restapi.API, args []string) error {
+		return commands.Apply(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"import ", func(api restapi.API, args []string) error {
+		return commands.Import(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"rollback cluster ", func(api restapi.API, args []string) error {
+		return commands.RollbackCluster(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"edit profile ", func(api restapi.API, args []string) error {
+		return commands.EditProfile(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"edit configuration ", func(api restapi.API, args []string) error {
+		return commands.EditClusterConfiguration(api, username, args, *configuration.askForConfirmation)
+	}},
+	{"enable configuration ", commands.EnableClusterConfigurations},
+	{"disable configuration ", commands.DisableClusterConfigurations},
+	{"activate configuration ", commands.ActivateClusterConfiguration},
+	{"activate must-gather ", commands.ActivateTriggers},
+	{"activate trigger ", commands.ActivateTriggers},
+	{"deactivate must-gather ", commands.DeactivateTriggers},
+	{"deactivate trigger ", commands.DeactivateTriggers},
+	{"prune triggers ", func(api restapi.API, args []string) error {
+		return commands.PruneTriggers(api, args, *configuration.askForConfirmation)
+	}},
+	// all arguments are optional, but the exit status needs to be
+	// reported even if none of them is specified
+	{"report triggers", commands.ReportTriggers},
+	{"serve alert-receiver", func(api restapi.API, args []string) error {
+		return commands.ServeAlertReceiver(api, username, args)
+	}},
+	{"audit", func(_ restapi.API, args []string) error {
+		return commands.Audit(args)
+	}},
+}
+
+// mutatingCommands is a list of commands that change state of controller.
+// These commands can not be performed in offline mode and they are refused
+// before user is asked for any input.
+var mutatingCommands = []string{
+	"add cluster", "new cluster",
+	"add profile", "new profile",
+	"add configuration", "new configuration",
+	"add trigger", "new trigger", "request must-gather",
+	"delete cluster", "delete profile", "delete configuration", "delete trigger",
+	"enable configuration", "disable configuration", "activate configuration",
+	"activate must-gather", "activate trigger",
+	"deactivate must-gather", "deactivate trigger",
+	"clone profile", "clone configuration",
+	"edit profile", "edit configuration",
+	"rollback cluster", "prune triggers",
+	"apply", "import", "serve alert-receiver", "daemon",
+}
+
+// mutatingFlags contains commands that change state of controller only when
+// the given flag is used
+var mutatingFlags = map[string]string{
+	"check": "fix",
+}
+
+// flagUsed function checks if boolean flag is set in command arguments
+func flagUsed(args, flag string) bool {
+	blocks, err := shellquote.Split(args)
+	if err != nil {
+		return false
+	}
+	for _, block := range blocks {
+		if !strings.HasPrefix(block, "-") {
+			continue
+		}
+		block = strings.TrimLeft(block, "-")
+		if block == flag || block == flag+"=true" {
+			return true
+		}
+	}
+	return false
+}
+
+// refusedInOfflineMode function checks if the command changes state of
+// controller and so it can not be performed in offline mode
+func refusedInOfflineMode(t string, offline bool) bool {
+	if !offline {
+		return false
+	}
+	for _, command := range mutatingCommands {
+		if t == command || strings.HasPrefix(t, command+" ") {
+			return true
+		}
+	}
+	for command, flag := range mutatingFlags {
+		if strings.HasPrefix(t, command+" ") && flagUsed(strings.TrimPrefix(t, command+" "), flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAsksForConfirmation function decides whether check command offers to
+// fix found problems. Problems can't be fixed in offline mode, so user is not
+// asked at all.
+func checkAsksForConfirmation(askForConfirmation, offline bool) bool {
+	return askForConfirmation && !offline
+}
+
 // executor tries to call the command specified on command line
 func executor(t string) {
+	// all messages are displayed by the command itself
+	_ = execute(t)
+}
+
+// execute function tries to call the command specified on command line and
+// returns an error if the command failed. Commands that don't report their
+// status are considered to be always successful.
+func execute(t string) error {
+	staleDataReported = false
+	currentCommand = t
+
+	// don't ask for input that could not be used anyway
+	if refusedInOfflineMode(t, *configuration.offline) {
+		fmt.Println(colorizer.Red("Command can not be performed in offline mode"))
+		fmt.Println(cache.ErrOffline)
+		return cache.ErrOffline
+	}
+
+	// commands with arguments and flags
+	for _, command := range commandsWithArgs {
+		if strings.HasPrefix(t, command.prefix) {
+			args, err := shellquote.Split(strings.TrimPrefix(t, command.prefix))
+			if err != nil {
+				fmt.Println(colorizer.Red(commands.InvalidCommandArgumentsErrorMessage))
+				fmt.Println(err)
+				return err
+			}
+			return command.handler(api, args)
+		}
+	}
+
 	blocks := strings.Split(t, " ")
 
 	// commands with variable parts
 	for _, command := range commandsWithParam {
 		if strings.HasPrefix(t, command.prefix) {
 			command.handler(api, blocks[2])
-			return
+			return nil
 		}
 	}
 
 	// no match? try commands without variable parts
 	executeFixedCommand(t)
+	return nil
 }
 
 // simpleCommand represents any command without parameter
@@ -164,6 +365,7 @@ var simpleCommands = []simpleCommand{
 	{"version", printVersion},
 	{"license", commands.PrintLicense},
 	{"authors", commands.PrintAuthors},
+	{"list schedules", commands.ListOfSchedules},
 }
 
 // commandsWithAPIParam represents any command with REST API parameter
@@ -223,9 +425,52 @@ func executeFixedCommand(t string) {
 		commands.AddClusterConfiguration(api, username)
 	case "request must-gather", "add trigger", "new trigger":
 		commands.AddTrigger(api, username)
+	case "describe cluster":
+		cluster := prompt.Input("cluster: ", commands.LoginCompleter)
+		_ = commands.DescribeCluster(api, []string{cluster})
 	case "describe profile":
 		profile := prompt.Input(profilePrompt, commands.LoginCompleter)
 		commands.DescribeProfile(api, profile)
+	case "history cluster":
+		cluster := prompt.Input("cluster: ", commands.LoginCompleter)
+		_ = commands.HistoryOfCluster(api, []string{cluster})
+	case "rollback cluster":
+		cluster := prompt.Input("cluster: ", commands.LoginCompleter)
+		_ = commands.RollbackCluster(api, username, []string{cluster},
+			*configuration.askForConfirmation)
+	case "activate configuration":
+		configurationID := configurationPrompt()
+		_ = commands.ActivateClusterConfiguration(api, []string{configurationID})
+	case "clone profile":
+		profile := prompt.Input(profilePrompt, commands.LoginCompleter)
+		_ = commands.CloneProfile(api, username, []string{profile},
+			*configuration.askForConfirmation)
+	case "daemon":
+		_ = commands.Daemon(api, []string{})
+	case "check":
+		_ = commands.Check(api, []string{},
+			checkAsksForConfirmation(*configuration.askForConfirmation, *configuration.offline))
+	case "diff":
+		_ = commands.Diff(api, []string{})
+	case "export":
+		_ = commands.Export(api, []string{})
+	case "drift":
+		_ = commands.Drift(api, []string{})
+	case "plan":
+		manifest := prompt.Input("manifest: ", commands.LoginCompleter)
+		_ = commands.Plan(api, []string{"-f", manifest})
+	case "apply":
+		manifest := prompt.Input("manifest: ", commands.LoginCompleter)
+		_ = commands.Apply(api, username, []string{"-f", manifest},
+			*configuration.askForConfirmation)
+	case "edit profile":
+		profile := prompt.Input(profilePrompt, commands.LoginCompleter)
+		_ = commands.EditProfile(api, username, []string{profile},
+			*configuration.askForConfirmation)
+	case "edit configuration":
+		configurationID := configurationPrompt()
+		_ = commands.EditClusterConfiguration(api, username, []string{configurationID},
+			*configuration.askForConfirmation)
 	case "describe configuration":
 		configuration := configurationPrompt()
 		commands.DescribeConfiguration(api, configuration)
@@ -282,8 +527,27 @@ func completer(in prompt.Document) []prompt.Suggest {
 		{Text: "delete", Description: "delete resource (configuration, trigger)"},
 		{Text: "enable", Description: "enable selected cluster profile"},
 		{Text: "disable", Description: "disable selected cluster profile"},
-		{Text: "activate", Description: "activate resource (trigger)"},
+		{Text: "check", Description: "check that each cluster has exactly one active configuration"},
+		{Text: "clone", Description: "create copy of resource (profile, configuration)"},
+		{Text: "diff", Description: "show differences between profiles, configurations and files"},
+		{Text: "drift", Description: "compare local directory with profiles and configurations in controller"},
+		{Text: "history", Description: "show history of cluster configurations"},
+		{Text: "rollback", Description: "make previous configuration of cluster active again"},
+		{Text: "apply", Description: "change controller state to the state described by manifest"},
+		{Text: "plan", Description: "show actions needed to reach the state described by manifest"},
+		{Text: "edit", Description: "edit resource (profile, configuration) in text editor"},
+		{Text: "export", Description: "export state of controller into archive"},
+		{Text: "import", Description: "import state of controller from archive"},
+		{Text: "activate", Description: "activate resource (configuration, trigger)"},
 		{Text: "deactivate", Description: "deactivate resource (trigger)"},
+		{Text: "prune", Description: "deactivate or delete triggers that have not been acknowledged"},
+		{Text: "report", Description: "report statistics of time to ack (triggers)"},
+		{Text: "watch", Description: "periodically refresh list and highlight changes"},
+		{Text: "schedule", Description: "schedule command to be performed by daemon (--cron or --at)"},
+		{Text: "cancel", Description: "cancel scheduled job"},
+		{Text: "daemon", Description: "run scheduled jobs"},
+		{Text: "serve", Description: "start server (alert-receiver)"},
+		{Text: "audit", Description: "show local audit log of all operations that changed controller state"},
 		{Text: "version", Description: "prints the build information for CLI executable"},
 		{Text: "copyright", Description: "displays copyright notice"},
 		{Text: "license", Description: "displays license used by this project"},
@@ -300,6 +564,27 @@ func completer(in prompt.Document) []prompt.Suggest {
 		{Text: "configurations", Description: "show list all cluster configurations"},
 		{Text: "must-gather", Description: "show list all must-gathers"},
 		{Text: "triggers", Description: "show list with all must-gather triggers"},
+		{Text: "schedules", Description: "show list of scheduled jobs"},
+	}
+
+	// prune operations
+	secondWord["prune"] = []prompt.Suggest{
+		{Text: "triggers", Description: "deactivate or delete stale triggers (--older-than 7d)"},
+	}
+
+	// report operations
+	secondWord["report"] = []prompt.Suggest{
+		{Text: "triggers", Description: "time to ack per cluster and requester, triggers not acked in time"},
+	}
+
+	// servers
+	secondWord["serve"] = []prompt.Suggest{
+		{Text: "alert-receiver", Description: "create must-gather triggers for Alertmanager alerts (--listen :9099)"},
+	}
+
+	// cancel operations
+	secondWord["cancel"] = []prompt.Suggest{
+		{Text: "schedule", Description: "cancel scheduled job"},
 	}
 
 	// add operations
@@ -323,6 +608,11 @@ func completer(in prompt.Document) []prompt.Suggest {
 		{Text: "must-gather", Description: "request must-gather"},
 	}
 
+	// watch operations
+	secondWord["watch"] = []prompt.Suggest{
+		{Text: "list", Description: "watch list of clusters, profiles, configurations or triggers"},
+	}
+
 	// enable operations
 	secondWord["enable"] = []prompt.Suggest{
 		{Text: "configuration", Description: "enable cluster configuration"},
@@ -333,6 +623,28 @@ func completer(in prompt.Document) []prompt.Suggest {
 		{Text: "configuration", Description: "disable cluster configuration"},
 	}
 
+	// clone operations
+	secondWord["clone"] = []prompt.Suggest{
+		{Text: "profile", Description: "create copy of configuration profile"},
+		{Text: "configuration", Description: "create copy of configuration for another cluster"},
+	}
+
+	// history operations
+	secondWord["history"] = []prompt.Suggest{
+		{Text: "cluster", Description: "show configurations of selected cluster in chronological order"},
+	}
+
+	// rollback operations
+	secondWord["rollback"] = []prompt.Suggest{
+		{Text: "cluster", Description: "roll back cluster to previous configuration"},
+	}
+
+	// edit operations
+	secondWord["edit"] = []prompt.Suggest{
+		{Text: "profile", Description: "edit configuration profile in text editor"},
+		{Text: "configuration", Description: "edit cluster configuration in text editor"},
+	}
+
 	// delete operations
 	secondWord["delete"] = []prompt.Suggest{
 		{Text: "cluster", Description: "delete cluster and its configuration"},
@@ -343,6 +655,7 @@ func completer(in prompt.Document) []prompt.Suggest {
 
 	// descripbe operations
 	secondWord["describe"] = []prompt.Suggest{
+		{Text: "cluster", Description: "describe cluster with its configurations and triggers"},
 		{Text: "profile", Description: "describe selected configuration profile"},
 		{Text: "configuration", Description: "describe configuration for selected cluster"},
 		{Text: "trigger", Description: "describe selected must-gather trigger"},
@@ -351,6 +664,7 @@ func completer(in prompt.Document) []prompt.Suggest {
 
 	// activate operations
 	secondWord["activate"] = []prompt.Suggest{
+		{Text: "configuration", Description: "make configuration the only active one for its cluster"},
 		{Text: "trigger", Description: "activate selected must-gather trigger"},
 		{Text: "must-gather", Description: "activate selected must-gather"},
 	}
@@ -382,6 +696,160 @@ func completer(in prompt.Document) []prompt.Suggest {
 	return prompt.FilterHasPrefix(firstWord, blocks[0], true)
 }
 
+// printStaleDataBanner function informs user that displayed data are read
+// from local cache and might be outdated. The banner is displayed just once
+// for each command.
+func printStaleDataBanner(key string, storedAt time.Time) {
+	if staleDataReported {
+		return
+	}
+	staleDataReported = true
+	fmt.Println(colorizer.Yellow("Offline mode: data are stale as of " +
+		storedAt.Format(time.RFC1123)))
+}
+
+// initializeAPI function constructs implementation of API interface used by
+// all commands. In online mode the REST API is used and results of all read
+// operations are stored into local cache. In offline mode just the local
+// cache is used.
+func initializeAPI(controllerURL, cacheFile string, offline bool) restapi.API {
+	var err error
+
+	// use the default cache file if it is not specified in configuration
+	if cacheFile == "" {
+		cacheFile, err = cache.DefaultStoreFile()
+	}
+
+	var store *cache.Store
+	if err == nil {
+		store, err = cache.NewStore(cacheFile)
+	}
+
+	// local cache is not available, but the REST API can still be used
+	if err != nil {
+		fmt.Println(colorizer.Red("Local cache can not be used"))
+		fmt.Println(err)
+		if offline {
+			os.Exit(1)
+		}
+		return restapi.NewRestAPI(controllerURL)
+	}
+
+	if offline {
+		return cache.NewOfflineAPI(store, printStaleDataBanner)
+	}
+	return cache.NewCachingAPI(restapi.NewRestAPI(controllerURL), store)
+}
+
+// rollbackLogFile function returns name of file where all rollbacks are
+// recorded. The file is stored next to the default cache file if it is not
+// specified in configuration.
+func rollbackLogFile(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	directory, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(directory, "insights-operator-cli", "rollbacks.jsonl")
+}
+
+// scheduleFile function returns name of file where scheduled jobs are
+// stored. The default file is stored next to the default cache file if it is
+// not specified in configuration.
+func scheduleFile(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	fileName, err := schedule.DefaultStoreFile()
+	if err != nil {
+		return ""
+	}
+	return fileName
+}
+
+// auditLogFile function returns name of file where all mutating operations
+// are recorded. The default file is stored next to the default cache file if
+// it is not specified in configuration.
+func auditLogFile(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	fileName, err := audit.DefaultLogFile()
+	if err != nil {
+		return ""
+	}
+	return fileName
+}
+
+// osUser function returns name of user logged in operating system
+func osUser() string {
+	current, err := user.Current()
+	if err != nil {
+		return os.Getenv("USER")
+	}
+	return current.Username
+}
+
+// withAuditLog function wraps the API implementation, so all mutating
+// operations are recorded in audit log together with the context in which
+// they have been performed
+func withAuditLog(wrapped restapi.API, controllerURL, fileName string) restapi.API {
+	if fileName == "" {
+		return wrapped
+	}
+	log := audit.NewLog(fileName)
+	commands.SetAuditLog(log)
+
+	systemUser := osUser()
+	return audit.NewAPI(wrapped, log, func() audit.Context {
+		return audit.Context{
+			OSUser:     systemUser,
+			Username:   username,
+			Controller: controllerURL,
+			Command:    currentCommand,
+		}
+	})
+}
+
+// configureTriggerTypes function reads trigger types supported by the
+// controller and their parameters from configuration. Just the built-in
+// must-gather trigger is known when the configuration is invalid.
+func configureTriggerTypes() {
+	var configured []triggers.Type
+	err := viper.UnmarshalKey("trigger_types", &configured)
+	if err == nil {
+		err = triggers.Configure(configured)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red("Trigger types can not be configured"))
+		fmt.Println(err)
+	}
+}
+
+// configureNotifications function reads webhook endpoints from configuration
+// and sets notifier used by watch and daemon modes. Notifications are not
+// sent when the configuration is invalid.
+func configureNotifications() {
+	var endpoints []notifications.Endpoint
+	err := viper.UnmarshalKey("webhooks", &endpoints)
+	if err == nil && len(endpoints) == 0 {
+		return
+	}
+
+	var notifier *notifications.Notifier
+	if err == nil {
+		notifier, err = notifications.NewNotifier(endpoints)
+	}
+	if err != nil {
+		fmt.Println(colorizer.Red("Notifications can not be configured"))
+		fmt.Println(err)
+		return
+	}
+	commands.SetNotifier(notifier)
+}
+
 // readConfiguration function reads configuration from configuration file and
 // via CLI flags.
 func readConfiguration(filename string) (Configuration, error) {
@@ -403,6 +871,8 @@ func readConfiguration(filename string) (Configuration, error) {
 		"enable or disable command line completer")
 	config.askForConfirmation = flag.Bool("confirmation", true,
 		"enable or disable asking for confirmation for selected actions (like delete)")
+	config.offline = flag.Bool("offline", false,
+		"serve read commands from local cache, refuse all other commands")
 	flag.Parse()
 
 	return config, nil
@@ -411,8 +881,9 @@ func readConfiguration(filename string) (Configuration, error) {
 // main function represents entry point to CLI client called right after the
 // process is started.
 func main() {
-	// read configuration
-	configuration, err := readConfiguration("config")
+	// read configuration, it is used by all commands
+	var err error
+	configuration, err = readConfiguration("config")
 	if err != nil {
 		panic(err)
 	}
@@ -421,9 +892,41 @@ func main() {
 	colorizer = aurora.NewAurora(*configuration.colors)
 	commands.SetColorizer(colorizer)
 
+	// links are clickable only on terminals that support them
+	commands.SetHyperlinks(*configuration.colors && commands.HyperlinksSupported())
+
+	// reasons of rollbacks are recorded locally
+	commands.SetRollbackLog(rollbackLogFile(viper.GetString("ROLLBACK_LOG")))
+
+	// jobs performed by daemon are stored locally
+	commands.SetScheduleFile(scheduleFile(viper.GetString("SCHEDULE_FILE")))
+
+	// new triggers can be refused for clusters with pending triggers
+	commands.SetRefuseDuplicateTriggers(viper.GetBool("REFUSE_DUPLICATE_TRIGGERS"))
+
+	// state changes are notified to configured webhooks
+	configureNotifications()
+
+	// trigger types other than must-gather are described in configuration
+	configureTriggerTypes()
+
 	// initialize REST API connection to service
 	controllerURL := viper.GetString("CONTROLLER_URL")
-	api = restapi.NewRestAPI(controllerURL)
+	api = initializeAPI(controllerURL, viper.GetString("CACHE_FILE"),
+		*configuration.offline)
+
+	// all mutating operations are recorded locally
+	api = withAuditLog(api, controllerURL, auditLogFile(viper.GetString("AUDIT_LOG")))
+
+	// just one command specified on command line is to be executed, the
+	// exit status reflects the result of this command
+	if flag.NArg() > 0 {
+		err := execute(shellquote.Join(flag.Args()...))
+		if err != nil {
+			os.Exit(commands.ExitStatus(err))
+		}
+		return
+	}
 
 	// start the command line
 	if *configuration.useCompleter {
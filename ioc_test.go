@@ -117,3 +117,48 @@ func TestPrintVersion(t *testing.T) {
 	// just print the version w/o any checks
 	main.PrintVersion()
 }
+
+// TestRefusedInOfflineMode function checks which commands are refused in
+// offline mode before user is asked for any input.
+func TestRefusedInOfflineMode(t *testing.T) {
+	testCases := []struct {
+		command  string
+		offline  bool
+		expected bool
+	}{
+		{"add trigger", true, true},
+		{"new configuration", true, true},
+		{"delete profile 42", true, true},
+		{"disable configuration 1,2", true, true},
+		{"add trigger", false, false},
+		{"list triggers", true, false},
+		{"describe configuration 1", true, false},
+		{"applyx", true, false},
+		{"check", true, false},
+		{"check --fix", true, true},
+		{"check -fix", true, true},
+		{"check --fix=true", true, true},
+		{"check --fix=false", true, false},
+		{"check --fix", false, false},
+		{"daemon", true, true},
+		{"daemon --once", true, true},
+	}
+
+	for _, testCase := range testCases {
+		refused := main.RefusedInOfflineMode(testCase.command, testCase.offline)
+		if refused != testCase.expected {
+			t.Fatal("Unexpected result for command", testCase.command, "offline", testCase.offline, refused)
+		}
+	}
+}
+
+// TestCheckAsksForConfirmation function checks that check command does not
+// offer to fix problems in offline mode.
+func TestCheckAsksForConfirmation(t *testing.T) {
+	if !main.CheckAsksForConfirmation(true, false) {
+		t.Fatal("User should be asked in online mode")
+	}
+	if main.CheckAsksForConfirmation(true, true) || main.CheckAsksForConfirmation(false, false) {
+		t.Fatal("User should not be asked")
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notifications contains implementation of outbound notifications
+// about state changes of triggers and cluster configurations. Changes are
+// detected by comparing two snapshots of controller state and they are sent
+// to configured webhook endpoints either as generic JSON documents or in
+// format accepted by Slack incoming webhooks. Message of each notification
+// can be customized by a template.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * events.go
+//
+// * queue.go
+//
+// * webhook.go
+package notifications
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/notifications
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/events.html
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// kinds of objects whose state changes are detected
+const (
+	TriggerKind       = "trigger"
+	ConfigurationKind = "configuration"
+)
+
+// state transitions of triggers and configurations
+const (
+	Created      = "created"
+	Deleted      = "deleted"
+	Acknowledged = "acknowledged"
+	Activated    = "activated"
+	Deactivated  = "deactivated"
+	Enabled      = "enabled"
+	Disabled     = "disabled"
+)
+
+// Event represents state transition of one trigger or configuration
+type Event struct {
+	Kind       string    `json:"kind"`
+	Transition string    `json:"transition"`
+	ID         int       `json:"id"`
+	Cluster    string    `json:"cluster"`
+	User       string    `json:"user"`
+	Type       string    `json:"type,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	Link       string    `json:"link,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// Name method returns name of event, for example "trigger acknowledged",
+// that is used to select events sent to endpoint
+func (event Event) Name() string {
+	return event.Kind + " " + event.Transition
+}
+
+// Snapshot represents state of controller at given time. Nil lists mean that
+// the state of such objects is not known and their changes are not detected.
+type Snapshot struct {
+	Triggers       []types.Trigger
+	Configurations []types.ClusterConfiguration
+}
+
+// triggerEvent function constructs event for trigger
+func triggerEvent(trigger types.Trigger, transition string, now time.Time) Event {
+	return Event{
+		Kind:       TriggerKind,
+		Transition: transition,
+		ID:         trigger.ID,
+		Cluster:    trigger.Cluster,
+		User:       trigger.TriggeredBy,
+		Type:       trigger.Type,
+		Reason:     trigger.Reason,
+		Link:       trigger.Link,
+		Time:       now,
+	}
+}
+
+// configurationEvent function constructs event for cluster configuration
+func configurationEvent(configuration types.ClusterConfiguration, transition string, now time.Time) Event {
+	return Event{
+		Kind:       ConfigurationKind,
+		Transition: transition,
+		ID:         configuration.ID,
+		Cluster:    configuration.Cluster,
+		User:       configuration.ChangedBy,
+		Reason:     configuration.Reason,
+		Time:       now,
+	}
+}
+
+// triggerTransitions function returns transitions between two states of
+// trigger
+func triggerTransitions(previous, current types.Trigger) []string {
+	transitions := []string{}
+	if !triggers.Acked(previous) && triggers.Acked(current) {
+		transitions = append(transitions, Acknowledged)
+	}
+	switch {
+	case previous.Active != 1 && current.Active == 1:
+		transitions = append(transitions, Activated)
+	case previous.Active == 1 && current.Active != 1:
+		transitions = append(transitions, Deactivated)
+	}
+	return transitions
+}
+
+// detectTriggerChanges function returns events for triggers that have been
+// created, deleted or changed their state
+func detectTriggerChanges(previous, current []types.Trigger, now time.Time) []Event {
+	known := make(map[int]types.Trigger, len(previous))
+	for _, trigger := range previous {
+		known[trigger.ID] = trigger
+	}
+
+	events := []Event{}
+	for _, trigger := range current {
+		old, found := known[trigger.ID]
+		if !found {
+			events = append(events, triggerEvent(trigger, Created, now))
+			continue
+		}
+		delete(known, trigger.ID)
+		for _, transition := range triggerTransitions(old, trigger) {
+			events = append(events, triggerEvent(trigger, transition, now))
+		}
+	}
+	for _, trigger := range known {
+		events = append(events, triggerEvent(trigger, Deleted, now))
+	}
+	return events
+}
+
+// detectConfigurationChanges function returns events for configurations
+// that have been created, deleted, enabled or disabled
+func detectConfigurationChanges(previous, current []types.ClusterConfiguration, now time.Time) []Event {
+	known := make(map[int]types.ClusterConfiguration, len(previous))
+	for _, configuration := range previous {
+		known[configuration.ID] = configuration
+	}
+
+	events := []Event{}
+	for _, configuration := range current {
+		old, found := known[configuration.ID]
+		if !found {
+			events = append(events, configurationEvent(configuration, Created, now))
+			continue
+		}
+		delete(known, configuration.ID)
+		switch {
+		case old.Active != "1" && configuration.Active == "1":
+			events = append(events, configurationEvent(configuration, Enabled, now))
+		case old.Active == "1" && configuration.Active != "1":
+			events = append(events, configurationEvent(configuration, Disabled, now))
+		}
+	}
+	for _, configuration := range known {
+		events = append(events, configurationEvent(configuration, Deleted, now))
+	}
+	return events
+}
+
+// Detect function returns events for all state changes between two
+// snapshots. Events are ordered by kind of object and by their IDs.
+func Detect(previous, current Snapshot, now time.Time) []Event {
+	events := []Event{}
+	if previous.Triggers != nil && current.Triggers != nil {
+		events = append(events, detectTriggerChanges(previous.Triggers, current.Triggers, now)...)
+	}
+	if previous.Configurations != nil && current.Configurations != nil {
+		events = append(events, detectConfigurationChanges(previous.Configurations, current.Configurations, now)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Kind != events[j].Kind {
+			return events[i].Kind > events[j].Kind
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events
+}
+
+// String method returns short description of event
+func (event Event) String() string {
+	return fmt.Sprintf("%s %d for cluster %s %s", event.Kind, event.ID, event.Cluster, event.Transition)
+}
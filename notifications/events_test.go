@@ -0,0 +1,118 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/events_test.html
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// now is time used for detected events
+var now = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// eventNames is a helper function that returns descriptions of all events
+func eventNames(events []notifications.Event) string {
+	names := []string{}
+	for _, event := range events {
+		names = append(names, event.String())
+	}
+	return strings.Join(names, "\n")
+}
+
+// TestDetectTriggerChanges function checks detection of state transitions of
+// triggers.
+func TestDetectTriggerChanges(t *testing.T) {
+	const notAcked = "1970-01-01T00:00:00"
+	previous := notifications.Snapshot{Triggers: []types.Trigger{
+		{ID: 1, Cluster: "cluster-a", AckedAt: notAcked, Active: 1},
+		{ID: 2, Cluster: "cluster-a", AckedAt: notAcked, Active: 1},
+		{ID: 3, Cluster: "cluster-b", AckedAt: notAcked, Active: 0},
+		{ID: 4, Cluster: "cluster-b", AckedAt: notAcked, Active: 1},
+		{ID: 5, Cluster: "cluster-b", AckedAt: "2020-01-01T00:00:00", Active: 1},
+	}}
+	current := notifications.Snapshot{Triggers: []types.Trigger{
+		{ID: 1, Cluster: "cluster-a", AckedAt: "2020-01-01T11:59:00Z", Active: 0},
+		{ID: 2, Cluster: "cluster-a", AckedAt: notAcked, Active: 1},
+		{ID: 3, Cluster: "cluster-b", AckedAt: notAcked, Active: 1},
+		{ID: 5, Cluster: "cluster-b", AckedAt: "2020-01-01T00:00:00", Active: 1},
+		{ID: 6, Cluster: "cluster-c", AckedAt: notAcked, Active: 1},
+	}}
+
+	expected := []string{
+		"trigger 1 for cluster cluster-a acknowledged",
+		"trigger 1 for cluster cluster-a deactivated",
+		"trigger 3 for cluster cluster-b activated",
+		"trigger 4 for cluster cluster-b deleted",
+		"trigger 6 for cluster cluster-c created",
+	}
+	events := notifications.Detect(previous, current, now)
+	if eventNames(events) != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected events:\n", eventNames(events))
+	}
+	if events[0].Name() != "trigger acknowledged" || !events[0].Time.Equal(now) {
+		t.Fatal("Unexpected event:", events[0])
+	}
+}
+
+// TestDetectConfigurationChanges function checks detection of state
+// transitions of configurations.
+func TestDetectConfigurationChanges(t *testing.T) {
+	previous := notifications.Snapshot{Configurations: []types.ClusterConfiguration{
+		{ID: 1, Cluster: "prod-1", Active: "1"},
+		{ID: 2, Cluster: "prod-1", Active: "0"},
+		{ID: 3, Cluster: "prod-2", Active: "1"},
+	}}
+	current := notifications.Snapshot{Configurations: []types.ClusterConfiguration{
+		{ID: 1, Cluster: "prod-1", Active: "0", ChangedBy: "tester"},
+		{ID: 2, Cluster: "prod-1", Active: "1", ChangedBy: "tester"},
+		{ID: 4, Cluster: "prod-2", Active: "1", ChangedBy: "tester"},
+	}}
+
+	expected := []string{
+		"configuration 1 for cluster prod-1 disabled",
+		"configuration 2 for cluster prod-1 enabled",
+		"configuration 3 for cluster prod-2 deleted",
+		"configuration 4 for cluster prod-2 created",
+	}
+	events := notifications.Detect(previous, current, now)
+	if eventNames(events) != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected events:\n", eventNames(events))
+	}
+	if events[0].User != "tester" {
+		t.Fatal("Unexpected event:", events[0])
+	}
+}
+
+// TestDetectUnknownState function checks that changes are not detected for
+// objects whose state is not known.
+func TestDetectUnknownState(t *testing.T) {
+	previous := notifications.Snapshot{}
+	current := notifications.Snapshot{
+		Triggers:       []types.Trigger{{ID: 1}},
+		Configurations: []types.ClusterConfiguration{{ID: 1}},
+	}
+	if events := notifications.Detect(previous, current, now); len(events) != 0 {
+		t.Fatal("Unexpected events:\n", eventNames(events))
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/notifications
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/queue.html
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DefaultQueueSize is the number of groups of events that can wait for
+// sending
+const DefaultQueueSize = 100
+
+// Queue sends notifications in background, so endpoints that are slow or not
+// available don't block the caller. Failures are collected and they are
+// returned by the Failures method.
+type Queue struct {
+	notifier *Notifier
+	groups   chan []Event
+	pending  sync.WaitGroup
+
+	mutex    sync.Mutex
+	failures []string
+}
+
+// NewQueue function constructs new queue with given capacity and starts
+// goroutine that sends queued notifications
+func NewQueue(notifier *Notifier, size int) *Queue {
+	queue := &Queue{
+		notifier: notifier,
+		groups:   make(chan []Event, size),
+	}
+	go queue.run()
+	return queue
+}
+
+// run method sends queued notifications until the queue is closed
+func (queue *Queue) run() {
+	for events := range queue.groups {
+		err := queue.notifier.Notify(events)
+		if err != nil {
+			queue.mutex.Lock()
+			queue.failures = append(queue.failures, err.Error())
+			queue.mutex.Unlock()
+		}
+		queue.pending.Done()
+	}
+}
+
+// Add method queues events to be sent. Events are dropped and error is
+// returned when the queue is full.
+func (queue *Queue) Add(events []Event) error {
+	queue.pending.Add(1)
+	select {
+	case queue.groups <- events:
+		return nil
+	default:
+		queue.pending.Done()
+		return fmt.Errorf("queue of notifications is full, %d events have been dropped", len(events))
+	}
+}
+
+// Failures method returns error describing notifications that failed since
+// the previous call, nil is returned when there's no such notification
+func (queue *Queue) Failures() error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if len(queue.failures) == 0 {
+		return nil
+	}
+	err := errors.New(strings.Join(queue.failures, "; "))
+	queue.failures = nil
+	return err
+}
+
+// Flush method waits until all queued notifications are sent
+func (queue *Queue) Flush() {
+	queue.pending.Wait()
+}
+
+// Close method stops the goroutine that sends notifications, events that
+// are already queued are still sent
+func (queue *Queue) Close() {
+	close(queue.groups)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/queue_test.html
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+)
+
+// TestQueue function checks that queued notifications are sent in
+// background.
+func TestQueue(t *testing.T) {
+	standIn := newWebhookStandIn()
+	defer standIn.Close()
+
+	waits := []time.Duration{}
+	queue := notifications.NewQueue(newNotifier(t, &waits, notifications.Endpoint{URL: standIn.URL}), 10)
+	defer queue.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := queue.Add([]notifications.Event{ackedEvent}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	queue.Flush()
+	if len(standIn.received()) != 2 {
+		t.Fatal("Unexpected number of requests:", len(standIn.received()))
+	}
+	if err := queue.Failures(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestQueueDoesNotBlock function checks that caller is not blocked while
+// notification is being retried, that events are dropped when the queue is
+// full and that failures are reported later.
+func TestQueueDoesNotBlock(t *testing.T) {
+	standIn := newWebhookStandIn(http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	defer standIn.Close()
+
+	notifier, err := notifications.NewNotifier([]notifications.Endpoint{{URL: standIn.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier.Retries = 1
+	waiting := make(chan struct{})
+	release := make(chan struct{})
+	notifier.Sleep = func(time.Duration) {
+		waiting <- struct{}{}
+		<-release
+	}
+
+	queue := notifications.NewQueue(notifier, 1)
+	defer queue.Close()
+
+	// the first group is being retried, the second one waits in queue
+	if err := queue.Add([]notifications.Event{ackedEvent}); err != nil {
+		t.Fatal(err)
+	}
+	<-waiting
+	if err := queue.Add([]notifications.Event{ackedEvent}); err != nil {
+		t.Fatal(err)
+	}
+	err = queue.Add([]notifications.Event{ackedEvent, ackedEvent})
+	if err == nil || !strings.Contains(err.Error(), "2 events have been dropped") {
+		t.Fatal("Full queue should be reported:", err)
+	}
+
+	close(release)
+	queue.Flush()
+	err = queue.Failures()
+	if err == nil || !strings.Contains(err.Error(), "503 Service Unavailable") {
+		t.Fatal("Failure should be reported:", err)
+	}
+	if queue.Failures() != nil {
+		t.Fatal("Failures should be reported just once")
+	}
+	if len(standIn.received()) != 3 {
+		t.Fatal("Unexpected number of requests:", len(standIn.received()))
+	}
+}
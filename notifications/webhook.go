@@ -0,0 +1,259 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/notifications
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/webhook.html
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// formats of payloads sent to webhook endpoints
+const (
+	JSONFormat  = "json"
+	SlackFormat = "slack"
+)
+
+// default settings of notifier
+const (
+	DefaultRetries = 3
+	DefaultBackoff = time.Second
+	DefaultTimeout = 10 * time.Second
+)
+
+// defaultTemplates contains templates of messages used when endpoint does
+// not specify its own template
+var defaultTemplates = map[string]string{
+	TriggerKind:       "Trigger {{.ID}} ({{.Type}}) for cluster {{.Cluster}} requested by {{.User}} has been {{.Transition}}",
+	ConfigurationKind: "Configuration {{.ID}} for cluster {{.Cluster}} has been {{.Transition}}",
+}
+
+// Endpoint represents webhook endpoint that receives notifications
+type Endpoint struct {
+	// URL where notifications are posted
+	URL string
+
+	// format of payload, json (default) or slack
+	Format string
+
+	// template of message, fields of Event can be used in it
+	Template string
+
+	// names of events sent to endpoint, for example "trigger
+	// acknowledged", all events are sent when the list is empty
+	Events []string
+
+	// shell patterns of clusters whose events are sent to endpoint, all
+	// clusters are selected when the list is empty
+	Clusters []string
+}
+
+// jsonPayload represents generic JSON payload
+type jsonPayload struct {
+	Event
+	Name    string `json:"event"`
+	Message string `json:"message"`
+}
+
+// slackPayload represents payload accepted by Slack incoming webhooks
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Validate method checks that endpoint is configured properly
+func (endpoint Endpoint) Validate() error {
+	if endpoint.URL == "" {
+		return errors.New("URL of endpoint needs to be specified")
+	}
+	switch endpoint.Format {
+	case "", JSONFormat, SlackFormat:
+	default:
+		return fmt.Errorf("unknown format of endpoint %s: %q", endpoint.URL, endpoint.Format)
+	}
+	if endpoint.Template != "" {
+		if _, err := template.New("message").Parse(endpoint.Template); err != nil {
+			return fmt.Errorf("invalid template of endpoint %s: %v", endpoint.URL, err)
+		}
+	}
+	for _, pattern := range endpoint.Clusters {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid cluster pattern of endpoint %s: %q", endpoint.URL, pattern)
+		}
+	}
+	return nil
+}
+
+// Matches method checks whether the event is to be sent to endpoint
+func (endpoint Endpoint) Matches(event Event) bool {
+	return matchesAny(endpoint.Events, func(name string) bool {
+		return strings.EqualFold(name, event.Name())
+	}) && matchesAny(endpoint.Clusters, func(pattern string) bool {
+		matched, err := path.Match(pattern, event.Cluster)
+		return err == nil && matched
+	})
+}
+
+// matchesAny function checks whether any item matches, empty list matches
+// everything
+func matchesAny(items []string, matches func(string) bool) bool {
+	if len(items) == 0 {
+		return true
+	}
+	for _, item := range items {
+		if matches(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Message method returns message describing the event
+func (endpoint Endpoint) Message(event Event) (string, error) {
+	text := endpoint.Template
+	if text == "" {
+		text = defaultTemplates[event.Kind]
+	}
+	messageTemplate, err := template.New("message").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var message strings.Builder
+	err = messageTemplate.Execute(&message, event)
+	return message.String(), err
+}
+
+// Payload method returns payload sent to endpoint for the event
+func (endpoint Endpoint) Payload(event Event) ([]byte, error) {
+	message, err := endpoint.Message(event)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Format == SlackFormat {
+		return json.Marshal(slackPayload{Text: message})
+	}
+	return json.Marshal(jsonPayload{Event: event, Name: event.Name(), Message: message})
+}
+
+// Notifier sends notifications about events to webhook endpoints
+type Notifier struct {
+	// endpoints that receive notifications
+	Endpoints []Endpoint
+
+	// HTTP client used to post notifications
+	Client *http.Client
+
+	// number of retries when endpoint is not available
+	Retries int
+
+	// time to wait before the first retry, it is doubled for each
+	// next retry
+	Backoff time.Duration
+
+	// function used to wait between retries
+	Sleep func(time.Duration)
+}
+
+// NewNotifier function constructs new notifier with default settings, all
+// endpoints are validated
+func NewNotifier(endpoints []Endpoint) (*Notifier, error) {
+	for _, endpoint := range endpoints {
+		if err := endpoint.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &Notifier{
+		Endpoints: endpoints,
+		Client:    &http.Client{Timeout: DefaultTimeout},
+		Retries:   DefaultRetries,
+		Backoff:   DefaultBackoff,
+		Sleep:     time.Sleep,
+	}, nil
+}
+
+// retryable function checks whether the request can succeed when it is
+// sent again
+func retryable(status int) bool {
+	return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+}
+
+// post method posts payload to URL once
+func (notifier *Notifier) post(url string, payload []byte) (bool, error) {
+	response, err := notifier.Client.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+	// the body needs to be read to reuse connection
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode/100 != 2 {
+		return retryable(response.StatusCode), fmt.Errorf("unexpected status %s", response.Status)
+	}
+	return false, nil
+}
+
+// send method posts payload to URL, the request is retried with growing
+// backoff when endpoint is not available
+func (notifier *Notifier) send(url string, payload []byte) error {
+	backoff := notifier.Backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := notifier.post(url, payload)
+		if err == nil || !retry || attempt >= notifier.Retries {
+			return err
+		}
+		notifier.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Notify method sends all events to endpoints that accept them. All
+// notifications are sent even if some of them fail, the returned error
+// describes all failures.
+func (notifier *Notifier) Notify(events []Event) error {
+	failures := []string{}
+	for _, event := range events {
+		for _, endpoint := range notifier.Endpoints {
+			if !endpoint.Matches(event) {
+				continue
+			}
+			payload, err := endpoint.Payload(event)
+			if err == nil {
+				err = notifier.send(endpoint.URL, payload)
+			}
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s to %s: %v", event, endpoint.URL, err))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
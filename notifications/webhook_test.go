@@ -0,0 +1,222 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/notifications/webhook_test.html
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/notifications"
+)
+
+// webhookStandIn is a local HTTP server that records received payloads, it
+// responds with given statuses and then with status 200
+type webhookStandIn struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	payloads []string
+	statuses []int
+}
+
+// newWebhookStandIn function starts new local webhook endpoint
+func newWebhookStandIn(statuses ...int) *webhookStandIn {
+	standIn := &webhookStandIn{statuses: statuses}
+	standIn.Server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		standIn.mutex.Lock()
+		defer standIn.mutex.Unlock()
+		standIn.payloads = append(standIn.payloads, string(body))
+		if len(standIn.statuses) > 0 {
+			writer.WriteHeader(standIn.statuses[0])
+			standIn.statuses = standIn.statuses[1:]
+		}
+	}))
+	return standIn
+}
+
+// received method returns all received payloads
+func (standIn *webhookStandIn) received() []string {
+	standIn.mutex.Lock()
+	defer standIn.mutex.Unlock()
+	return append([]string{}, standIn.payloads...)
+}
+
+// ackedEvent is an event used by tests
+var ackedEvent = notifications.Event{
+	Kind:       notifications.TriggerKind,
+	Transition: notifications.Acknowledged,
+	ID:         42,
+	Cluster:    "prod-1",
+	User:       "tester",
+	Type:       "must-gather",
+	Time:       now,
+}
+
+// newNotifier is a helper function that constructs notifier which does not
+// wait between retries and records the waits
+func newNotifier(t *testing.T, waits *[]time.Duration, endpoints ...notifications.Endpoint) *notifications.Notifier {
+	notifier, err := notifications.NewNotifier(endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier.Sleep = func(duration time.Duration) {
+		*waits = append(*waits, duration)
+	}
+	return notifier
+}
+
+// TestNotifyJSON function checks generic JSON payload.
+func TestNotifyJSON(t *testing.T) {
+	standIn := newWebhookStandIn()
+	defer standIn.Close()
+
+	notifier := newNotifier(t, &[]time.Duration{}, notifications.Endpoint{URL: standIn.URL})
+	if err := notifier.Notify([]notifications.Event{ackedEvent}); err != nil {
+		t.Fatal(err)
+	}
+
+	received := standIn.received()
+	if len(received) != 1 {
+		t.Fatal("Unexpected payloads:", received)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(received[0]), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload["event"] != "trigger acknowledged" || payload["cluster"] != "prod-1" || payload["id"] != 42.0 ||
+		payload["message"] != "Trigger 42 (must-gather) for cluster prod-1 requested by tester has been acknowledged" {
+		t.Fatal("Unexpected payload:", received[0])
+	}
+}
+
+// TestNotifySlackTemplate function checks Slack payload with message
+// specified by template.
+func TestNotifySlackTemplate(t *testing.T) {
+	standIn := newWebhookStandIn()
+	defer standIn.Close()
+
+	notifier := newNotifier(t, &[]time.Duration{}, notifications.Endpoint{
+		URL:      standIn.URL,
+		Format:   notifications.SlackFormat,
+		Template: "@{{.User}} must-gather for {{.Cluster}} is {{.Transition}}",
+	})
+	if err := notifier.Notify([]notifications.Event{ackedEvent}); err != nil {
+		t.Fatal(err)
+	}
+
+	received := standIn.received()
+	if len(received) != 1 || received[0] != `{"text":"@tester must-gather for prod-1 is acknowledged"}` {
+		t.Fatal("Unexpected payloads:", received)
+	}
+}
+
+// TestNotifyFilters function checks that endpoints receive just selected
+// events.
+func TestNotifyFilters(t *testing.T) {
+	acked := newWebhookStandIn()
+	defer acked.Close()
+	prod := newWebhookStandIn()
+	defer prod.Close()
+
+	notifier := newNotifier(t, &[]time.Duration{},
+		notifications.Endpoint{URL: acked.URL, Events: []string{"trigger acknowledged"}},
+		notifications.Endpoint{URL: prod.URL, Events: []string{"configuration enabled", "configuration disabled"}, Clusters: []string{"prod-*"}})
+
+	events := []notifications.Event{
+		ackedEvent,
+		{Kind: notifications.ConfigurationKind, Transition: notifications.Enabled, ID: 1, Cluster: "prod-1"},
+		{Kind: notifications.ConfigurationKind, Transition: notifications.Enabled, ID: 2, Cluster: "stage-1"},
+		{Kind: notifications.ConfigurationKind, Transition: notifications.Created, ID: 3, Cluster: "prod-1"},
+	}
+	if err := notifier.Notify(events); err != nil {
+		t.Fatal(err)
+	}
+
+	if received := acked.received(); len(received) != 1 || !strings.Contains(received[0], `"id":42`) {
+		t.Fatal("Unexpected payloads:", received)
+	}
+	if received := prod.received(); len(received) != 1 || !strings.Contains(received[0], "Configuration 1 for cluster prod-1 has been enabled") {
+		t.Fatal("Unexpected payloads:", received)
+	}
+}
+
+// TestNotifyRetries function checks that notification is sent again when
+// the endpoint is not available.
+func TestNotifyRetries(t *testing.T) {
+	standIn := newWebhookStandIn(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	defer standIn.Close()
+
+	waits := []time.Duration{}
+	notifier := newNotifier(t, &waits, notifications.Endpoint{URL: standIn.URL})
+	if err := notifier.Notify([]notifications.Event{ackedEvent}); err != nil {
+		t.Fatal(err)
+	}
+	if len(standIn.received()) != 3 {
+		t.Fatal("Unexpected number of requests:", len(standIn.received()))
+	}
+	if len(waits) != 2 || waits[0] != time.Second || waits[1] != 2*time.Second {
+		t.Fatal("Unexpected waits:", waits)
+	}
+}
+
+// TestNotifyFailure function checks that failures are reported and that
+// client errors are not retried.
+func TestNotifyFailure(t *testing.T) {
+	unavailable := newWebhookStandIn(500, 500, 500, 500, 500)
+	defer unavailable.Close()
+	invalid := newWebhookStandIn(http.StatusBadRequest)
+	defer invalid.Close()
+
+	waits := []time.Duration{}
+	notifier := newNotifier(t, &waits,
+		notifications.Endpoint{URL: unavailable.URL},
+		notifications.Endpoint{URL: invalid.URL})
+	err := notifier.Notify([]notifications.Event{ackedEvent})
+	if err == nil {
+		t.Fatal("Error is expected to be returned")
+	}
+	if len(unavailable.received()) != 1+notifications.DefaultRetries || len(invalid.received()) != 1 {
+		t.Fatal("Unexpected number of requests:", len(unavailable.received()), len(invalid.received()))
+	}
+	if !strings.Contains(err.Error(), "500 Internal Server Error") || !strings.Contains(err.Error(), "400 Bad Request") {
+		t.Fatal("Unexpected error:", err)
+	}
+}
+
+// TestInvalidEndpoints function checks validation of endpoints.
+func TestInvalidEndpoints(t *testing.T) {
+	invalid := []notifications.Endpoint{
+		{},
+		{URL: "http://localhost", Format: "xml"},
+		{URL: "http://localhost", Template: "{{.ID"},
+		{URL: "http://localhost", Clusters: []string{"prod-["}},
+	}
+	for _, endpoint := range invalid {
+		if _, err := notifications.NewNotifier([]notifications.Endpoint{endpoint}); err == nil {
+			t.Fatal("Error is expected for", endpoint)
+		}
+	}
+}
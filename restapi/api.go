@@ -39,6 +39,7 @@ type API interface {
 	ReadListOfConfigurationProfiles() ([]types.ConfigurationProfile, error)
 	ReadConfigurationProfile(profileID string) (*types.ConfigurationProfile, error)
 	AddConfigurationProfile(username string, description string, configuration []byte) error
+	UpdateConfigurationProfile(profileID string, username string, description string, configuration []byte) error
 	DeleteConfigurationProfile(profileID string) error
 
 	// configuration related commands
@@ -53,6 +54,7 @@ type API interface {
 	ReadListOfTriggers() ([]types.Trigger, error)
 	ReadTriggerByID(triggerID string) (*types.Trigger, error)
 	AddTrigger(username string, clusterName string, reason string, link string) error
+	AddTypedTrigger(username string, clusterName string, triggerType string, reason string, link string, parameters []byte) error
 	DeleteTrigger(triggerID string) error
 	ActivateTrigger(triggerID string) error
 	DeactivateTrigger(triggerID string) error
@@ -26,6 +26,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -313,6 +314,18 @@ func (api RestAPI) AddConfigurationProfile(username, description string, configu
 	return err
 }
 
+// UpdateConfigurationProfile access the REST API endpoint to update existing
+// configuration profile
+func (api RestAPI) UpdateConfigurationProfile(profileID, username, description string, configuration []byte) error {
+	query := usernameQuery + url.QueryEscape(username) + "&description=" + url.QueryEscape(description)
+	// construct URL to be used to access REST API endpoint
+	serviceURL := api.controllerURL + APIPrefix + clientProfileEndpoint + url.PathEscape(profileID) + "?" + query
+
+	// perform REST API call and return error code
+	err := performWriteRequest(serviceURL, http.MethodPut, bytes.NewReader(configuration))
+	return err
+}
+
 // AddClusterConfiguration access the REST API endpoint to add new cluster
 // configuration
 func (api RestAPI) AddClusterConfiguration(username, cluster, reason, description string, configuration []byte) error {
@@ -325,14 +338,28 @@ func (api RestAPI) AddClusterConfiguration(username, cluster, reason, descriptio
 	return err
 }
 
-// AddTrigger access the REST API endpoint to add/register new trigger
+// AddTrigger access the REST API endpoint to add/register new must-gather
+// trigger
 func (api RestAPI) AddTrigger(username, clusterName, reason, link string) error {
+	return api.AddTypedTrigger(username, clusterName, "must-gather", reason, link, nil)
+}
+
+// AddTypedTrigger access the REST API endpoint to add/register new trigger
+// of given type. Trigger parameters (if any) are sent in request body.
+func (api RestAPI) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
 	query := usernameQuery + url.QueryEscape(username) + "&reason=" + url.QueryEscape(reason) + "&link=" + url.QueryEscape(link)
 	// construct URL to be used to access REST API endpoint
-	serviceURL := api.controllerURL + APIPrefix + clientClusterEndpoint + url.PathEscape(clusterName) + "/trigger/must-gather?" + query
+	serviceURL := api.controllerURL + APIPrefix + clientClusterEndpoint + url.PathEscape(clusterName) +
+		"/trigger/" + url.PathEscape(triggerType) + "?" + query
+
+	// request without parameters has no body
+	var payload io.Reader
+	if parameters != nil {
+		payload = bytes.NewReader(parameters)
+	}
 
 	// perform REST API call and return error code
-	err := performWriteRequest(serviceURL, http.MethodPost, nil)
+	err := performWriteRequest(serviceURL, http.MethodPost, payload)
 	return err
 }
 
@@ -20,6 +20,7 @@ package restapi_test
 // https://redhatinsights.github.io/insights-operator-cli/packages/restapi/api_test.html
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 
@@ -1370,6 +1371,77 @@ func TestAddTriggerImproperNotFoundResponse(t *testing.T) {
 	expectError(t, err)
 }
 
+func TestAddTypedTriggerStandardResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/cluster/cluster2/trigger/insights-gather?username=name&reason=reason&link=link"
+	server := mockedHTTPServer(func(responseWriter http.ResponseWriter, request *http.Request) {
+		checkMethod(t, request, "POST")
+		checkURL(t, request, URL)
+		// parameters are sent in request body
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != `{"gatherers":["clusterconfig"]}` {
+			t.Error("Unexpected request body:", string(body))
+		}
+		err = writeBody(responseWriter, StatusOKJSON)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.AddTypedTrigger("name", "cluster2", "insights-gather", "reason", "link",
+		[]byte(`{"gatherers":["clusterconfig"]}`))
+	expectNoErrors(t, err)
+}
+
+func TestAddTypedTriggerErrorResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/cluster/cluster2/trigger/insights-gather?username=name&reason=reason&link=link"
+	server := mockedHTTPServer(standardHandlerForMethodImpl(t, URL, "POST", StatusErrorJSON))
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.AddTypedTrigger("name", "cluster2", "insights-gather", "reason", "link", nil)
+	expectError(t, err)
+}
+
+func TestAddTypedTriggerImproperJSONResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/cluster/cluster2/trigger/insights-gather?username=name&reason=reason&link=link"
+	server := mockedHTTPServer(standardHandlerForMethodImpl(t, URL, "POST", ImproperJSON))
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.AddTypedTrigger("name", "cluster2", "insights-gather", "reason", "link", nil)
+	expectError(t, err)
+}
+
+func TestAddTypedTriggerImproperNotFoundResponse(t *testing.T) {
+	// start a local HTTP server
+	server := httptest.NewServer(http.NotFoundHandler())
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.AddTypedTrigger("name", "cluster2", "insights-gather", "reason", "link", nil)
+	expectError(t, err)
+}
+
 func TestAddConfigurationProfileStandardResponse(t *testing.T) {
 	// start a local HTTP server
 	URL := "/api/v1/client/profile?username=name&description=description"
@@ -1425,6 +1497,61 @@ func TestAddConfigurationProfileNotFoundResponse(t *testing.T) {
 	expectError(t, err)
 }
 
+func TestUpdateConfigurationProfileStandardResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/profile/1?username=name&description=description"
+	server := mockedHTTPServer(standardHandlerForMethodImpl(t, URL, "PUT", StatusOKJSON))
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.UpdateConfigurationProfile("1", "name", "description", []byte{1, 2, 3})
+	expectNoErrors(t, err)
+}
+
+func TestUpdateConfigurationProfileErrorResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/profile/1?username=name&description=description"
+	server := mockedHTTPServer(standardHandlerForMethodImpl(t, URL, "PUT", StatusErrorJSON))
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.UpdateConfigurationProfile("1", "name", "description", []byte{1, 2, 3})
+	expectError(t, err)
+}
+
+func TestUpdateConfigurationProfileImproperJSONResponse(t *testing.T) {
+	// start a local HTTP server
+	URL := "/api/v1/client/profile/1?username=name&description=description"
+	server := mockedHTTPServer(standardHandlerForMethodImpl(t, URL, "PUT", ImproperJSON))
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.UpdateConfigurationProfile("1", "name", "description", []byte{1, 2, 3})
+	expectError(t, err)
+}
+
+func TestUpdateConfigurationProfileNotFoundResponse(t *testing.T) {
+	// start a local HTTP server
+	server := httptest.NewServer(http.NotFoundHandler())
+	// close the server when test finishes
+	defer server.Close()
+
+	api := restapi.NewRestAPI(server.URL)
+
+	// perform REST API call against mocked HTTP server
+	err := api.UpdateConfigurationProfile("1", "name", "description", []byte{1, 2, 3})
+	expectError(t, err)
+}
+
 func TestAddClusterConfigurationStandardResponse(t *testing.T) {
 	// start a local HTTP server
 	URL := "/api/v1/client/cluster/cluster2/configuration/create?username=name&reason=reason&description=description"
@@ -32,7 +32,7 @@ import (
 )
 
 const (
-	communicationErrorWithServerErrorMessage = "Communication error with the server %v"
+	communicationErrorWithServerErrorMessage = "Communication error with the server %w"
 	unableToReadResponseBodyError            = "Unable to read response body"
 )
 
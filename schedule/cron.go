@@ -0,0 +1,246 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/schedule
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/cron.html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// macros that can be used instead of cron expression
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// names that can be used in month and day of week fields
+var (
+	monthNames   = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// cronField describes allowed values of one field of cron expression
+type cronField struct {
+	name     string
+	min, max int
+
+	// names of values, the first name corresponds to the minimal value
+	names []string
+}
+
+// fields of cron expression in the order they are written
+var cronFields = []cronField{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, monthNames},
+	// both 0 and 7 represent Sunday
+	{"day of week", 0, 7, weekdayNames},
+}
+
+// maximal time span searched for the next run
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// Cron represents parsed cron expression with five fields: minute, hour, day
+// of month, month and day of week. Each field can contain * (any value),
+// single value, range a-b, list of values separated by comma and step /n.
+// Months and days of week can be written as three-letter names.
+type Cron struct {
+	expression string
+
+	// sets of allowed values for each field
+	minutes, hours, days, months, weekdays uint64
+
+	// day of month and day of week fields are combined by OR operator
+	// unless one of them starts with *
+	anyDay, anyWeekday bool
+}
+
+// ParseCron function parses cron expression or one of macros @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight and @hourly.
+func ParseCron(expression string) (*Cron, error) {
+	expression = strings.TrimSpace(expression)
+	expanded := expression
+	if macro, found := cronMacros[strings.ToLower(expression)]; found {
+		expanded = macro
+	}
+
+	parts := strings.Fields(expanded)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression %q needs to have %d fields", expression, len(cronFields))
+	}
+
+	sets := make([]uint64, len(cronFields))
+	for i, field := range cronFields {
+		set, err := field.parse(parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %v", expression, err)
+		}
+		sets[i] = set
+	}
+
+	// Sunday can be written as 7
+	weekdays := sets[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays |= 1
+	}
+
+	return &Cron{
+		expression: expression,
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   weekdays,
+		anyDay:     strings.HasPrefix(parts[2], "*"),
+		anyWeekday: strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+// parse method parses one field of cron expression into set of values
+func (field cronField) parse(text string) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(text, ",") {
+		from, to, step, err := field.parseItem(item)
+		if err != nil {
+			return 0, err
+		}
+		for value := from; value <= to; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+	return set, nil
+}
+
+// parseItem method parses one item of field list: *, value, range a-b, all
+// of them optionally followed by step /n
+func (field cronField) parseItem(item string) (from, to, step int, err error) {
+	step = 1
+	if base, stepText, found := strings.Cut(item, "/"); found {
+		step, err = strconv.Atoi(stepText)
+		if err != nil || step <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid step in %s field: %q", field.name, item)
+		}
+		item = base
+	}
+
+	switch {
+	case item == "*":
+		return field.min, field.max, step, nil
+	case strings.Contains(item, "-"):
+		low, high, _ := strings.Cut(item, "-")
+		if from, err = field.value(low); err != nil {
+			return 0, 0, 0, err
+		}
+		if to, err = field.value(high); err != nil {
+			return 0, 0, 0, err
+		}
+		if from > to {
+			return 0, 0, 0, fmt.Errorf("invalid range in %s field: %q", field.name, item)
+		}
+		return from, to, step, nil
+	default:
+		if from, err = field.value(item); err != nil {
+			return 0, 0, 0, err
+		}
+		// value with step means "from value to maximum"
+		to = from
+		if step > 1 {
+			to = field.max
+		}
+		return from, to, step, nil
+	}
+}
+
+// value method parses one value of field, names are accepted in month and
+// day of week fields
+func (field cronField) value(text string) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(text, name) {
+			return field.min + i, nil
+		}
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil || value < field.min || value > field.max {
+		return 0, fmt.Errorf("invalid value in %s field: %q (allowed %d-%d)", field.name, text, field.min, field.max)
+	}
+	return value, nil
+}
+
+// String method returns the original cron expression.
+func (cron *Cron) String() string {
+	return cron.expression
+}
+
+// contains function checks if value is in the set
+func contains(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
+
+// matchesDay method checks if the cron expression allows given day
+func (cron *Cron) matchesDay(t time.Time) bool {
+	day := contains(cron.days, t.Day())
+	weekday := contains(cron.weekdays, int(t.Weekday()))
+	switch {
+	case cron.anyDay && cron.anyWeekday:
+		return true
+	case cron.anyDay:
+		return weekday
+	case cron.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+// Next method returns the first time after the given time that matches the
+// cron expression. Zero time is returned when there is no such time in
+// following five years (for example for February 30th).
+func (cron *Cron) Next(after time.Time) time.Time {
+	location := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case !contains(cron.months, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+		case !cron.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+		case !contains(cron.hours, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+		case !contains(cron.minutes, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
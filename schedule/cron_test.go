@@ -0,0 +1,94 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/cron_test.html
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/schedule"
+)
+
+// start is time used as the base for computing next runs (Thursday)
+var start = time.Date(2026, 10, 15, 10, 30, 20, 0, time.UTC)
+
+// TestCronNext function checks computing of next run for various cron
+// expressions.
+func TestCronNext(t *testing.T) {
+	expected := map[string]time.Time{
+		"* * * * *":        time.Date(2026, 10, 15, 10, 31, 0, 0, time.UTC),
+		"0 2 * * *":        time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC),
+		"@daily":           time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		"@hourly":          time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC),
+		"*/15 * * * *":     time.Date(2026, 10, 15, 10, 45, 0, 0, time.UTC),
+		"30 10 * * *":      time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC),
+		"0 22 * * mon-fri": time.Date(2026, 10, 15, 22, 0, 0, 0, time.UTC),
+		"0 3 * * 7":        time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC),
+		"0 3 * * sat,sun":  time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC),
+		"0 0 1 jan *":      time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":       time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		"5-10/5 8 * * *":   time.Date(2026, 10, 16, 8, 5, 0, 0, time.UTC),
+		// day of month and day of week are combined by OR
+		"0 0 1 * mon": time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	}
+	for expression, next := range expected {
+		cron, err := schedule.ParseCron(expression)
+		if err != nil {
+			t.Fatal(expression, err)
+		}
+		if actual := cron.Next(start); !actual.Equal(next) {
+			t.Errorf("%s: expected %s, got %s", expression, next, actual)
+		}
+	}
+}
+
+// TestCronNeverMatches function checks that zero time is returned for
+// expressions that never match.
+func TestCronNeverMatches(t *testing.T) {
+	cron, err := schedule.ParseCron("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cron.Next(start).IsZero() {
+		t.Fatal("February 30th should never match")
+	}
+}
+
+// TestParseCronInvalid function checks that invalid expressions are refused.
+func TestParseCronInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"* * * foo *",
+		"@sometimes",
+	}
+	for _, expression := range invalid {
+		if _, err := schedule.ParseCron(expression); err == nil {
+			t.Errorf("Expression %q should be refused", expression)
+		}
+	}
+}
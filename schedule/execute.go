@@ -0,0 +1,61 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/schedule
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/execute.html
+
+import (
+	"fmt"
+
+	"github.com/RedHatInsights/insights-operator-cli/declarative"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+)
+
+// Execute function performs the action of job via REST API.
+func Execute(api restapi.API, job Job) error {
+	action := job.Action
+
+	switch action.Kind {
+	case AddTrigger:
+		var parameters []byte
+		if len(action.Parameters) > 0 {
+			parameters = action.Parameters
+		}
+		return api.AddTypedTrigger(job.Username, action.Cluster, action.TriggerType,
+			action.Reason, action.Link, parameters)
+	case EnableConfiguration:
+		return api.EnableClusterConfiguration(action.ConfigurationID)
+	case DisableConfiguration:
+		return api.DisableClusterConfiguration(action.ConfigurationID)
+	case ApplyManifest:
+		manifest, err := declarative.LoadManifest(action.Manifest)
+		if err != nil {
+			return err
+		}
+		plan, err := declarative.ComputePlan(api, manifest, action.Prune)
+		if err != nil {
+			return err
+		}
+		return declarative.Apply(api, plan, job.Username, nil)
+	default:
+		return fmt.Errorf("unknown action: %q", action.Kind)
+	}
+}
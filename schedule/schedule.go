@@ -0,0 +1,224 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule contains implementation of scheduled and recurring
+// operations. Each job performs one action (creates a trigger, enables or
+// disables a configuration or applies a manifest) either repeatedly,
+// according to a cron expression, or just once at given time. Jobs are
+// stored in a local file and they are executed by the CLI client running in
+// daemon mode.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * cron.go
+//
+// * execute.go
+//
+// * schedule.go
+//
+// * store.go
+package schedule
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/schedule
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/schedule.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/cache"
+)
+
+// ActionKind represents kind of operation performed by job
+type ActionKind string
+
+// all kinds of actions that can be scheduled
+const (
+	AddTrigger           ActionKind = "add trigger"
+	EnableConfiguration  ActionKind = "enable configuration"
+	DisableConfiguration ActionKind = "disable configuration"
+	ApplyManifest        ActionKind = "apply manifest"
+)
+
+// result of successful job run
+const resultOK = "ok"
+
+// ResultRunning is result of job that has been claimed by daemon and that is
+// being run
+const ResultRunning = "running"
+
+// Action represents operation performed by job
+type Action struct {
+	Kind ActionKind `json:"kind"`
+
+	// trigger to be created
+	Cluster     string          `json:"cluster,omitempty"`
+	TriggerType string          `json:"trigger_type,omitempty"`
+	Reason      string          `json:"reason,omitempty"`
+	Link        string          `json:"link,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+
+	// configuration to be enabled or disabled
+	ConfigurationID string `json:"configuration_id,omitempty"`
+
+	// manifest to be applied
+	Manifest string `json:"manifest,omitempty"`
+	Prune    bool   `json:"prune,omitempty"`
+}
+
+// Job represents scheduled operation. Exactly one of Cron and At fields is
+// set. NextRun is zero for jobs that won't be run anymore.
+type Job struct {
+	ID   int        `json:"id"`
+	Cron string     `json:"cron,omitempty"`
+	At   *time.Time `json:"at,omitempty"`
+
+	// command as entered by user and the action it represents
+	Command string `json:"command"`
+	Action  Action `json:"action"`
+
+	// user that scheduled the job, the action is performed on behalf of
+	// this user
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+
+	NextRun    time.Time  `json:"next_run"`
+	LastRun    *time.Time `json:"last_run,omitempty"`
+	LastResult string     `json:"last_result,omitempty"`
+}
+
+// Start method checks the schedule of job and computes the time of its first
+// run.
+func (job *Job) Start(now time.Time) error {
+	switch {
+	case job.Cron != "" && job.At != nil:
+		return errors.New("job can be scheduled either by cron expression or by time, not both")
+	case job.Cron != "":
+		cron, err := ParseCron(job.Cron)
+		if err != nil {
+			return err
+		}
+		job.NextRun = cron.Next(now)
+		if job.NextRun.IsZero() {
+			return fmt.Errorf("cron expression %q never matches", job.Cron)
+		}
+	case job.At != nil:
+		if !job.At.After(now) {
+			return fmt.Errorf("time %s is in the past", job.At.Format(time.RFC3339))
+		}
+		job.NextRun = *job.At
+	default:
+		return errors.New("job needs to be scheduled by cron expression or by time")
+	}
+	job.CreatedAt = now
+	return nil
+}
+
+// Due method checks if the job needs to be run at given time.
+func (job *Job) Due(now time.Time) bool {
+	return !job.NextRun.IsZero() && !job.NextRun.After(now)
+}
+
+// Claim method marks the job as running and computes the time of next run
+// before the job is performed, so the same run can't be performed by another
+// daemon.
+func (job *Job) Claim(now time.Time) {
+	job.LastRun = &now
+	job.LastResult = ResultRunning
+	job.advance(now)
+}
+
+// Finish method records the result of job run and computes the time of next
+// run. Runs missed (for example when the daemon was not running) are not
+// repeated. Claimed run that failed because the controller could not be
+// reached is run again with the next poll.
+func (job *Job) Finish(now time.Time, err error) {
+	claimedAt := job.LastRun
+	claimed := job.LastResult == ResultRunning && claimedAt != nil
+
+	job.LastRun = &now
+	job.LastResult = resultOK
+	if err != nil {
+		job.LastResult = "failed: " + err.Error()
+	}
+	if claimed && unreachable(err) {
+		job.NextRun = *claimedAt
+		return
+	}
+	job.advance(now)
+}
+
+// unreachable function checks if the error means that the controller could
+// not be reached at all, i.e. the action has not been performed
+func unreachable(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, cache.ErrOffline) || errors.As(err, &netErr)
+}
+
+// advance method computes the time of the next run after the given time,
+// one-shot jobs are not run anymore
+func (job *Job) advance(now time.Time) {
+	job.NextRun = time.Time{}
+	if job.Cron != "" {
+		if cron, err := ParseCron(job.Cron); err == nil {
+			job.NextRun = cron.Next(now)
+		}
+	}
+}
+
+// Schedule method returns human readable description of job schedule.
+func (job *Job) Schedule() string {
+	if job.At != nil {
+		return "at " + job.At.Format("2006-01-02 15:04")
+	}
+	return "cron " + job.Cron
+}
+
+// layouts of time accepted by ParseAt function
+var atLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+}
+
+// ParseAt function parses time when one-shot job needs to be run. Date with
+// time and time of day (the nearest one in future) are accepted, local time
+// zone is used when it is not specified.
+func ParseAt(value string, now time.Time) (time.Time, error) {
+	for _, layout := range atLayouts {
+		at, err := time.ParseInLocation(layout, value, now.Location())
+		if err == nil {
+			return at, nil
+		}
+	}
+
+	clock, err := time.ParseInLocation("15:04", value, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, use YYYY-MM-DD HH:MM or HH:MM", value)
+	}
+	at := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+	if !at.After(now) {
+		at = at.AddDate(0, 0, 1)
+	}
+	return at, nil
+}
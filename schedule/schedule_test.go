@@ -0,0 +1,224 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/schedule_test.html
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/cache"
+	"github.com/RedHatInsights/insights-operator-cli/restapi"
+	"github.com/RedHatInsights/insights-operator-cli/schedule"
+)
+
+// recordingAPI is an implementation of REST API that records calls of
+// methods used by scheduled jobs, other methods are not implemented
+type recordingAPI struct {
+	restapi.API
+	calls *[]string
+}
+
+// AddTypedTrigger records the call
+func (api recordingAPI) AddTypedTrigger(username, clusterName, triggerType, reason, link string, parameters []byte) error {
+	*api.calls = append(*api.calls, strings.Join([]string{"trigger", username, clusterName, triggerType, reason, link, string(parameters)}, " "))
+	return nil
+}
+
+// EnableClusterConfiguration records the call
+func (api recordingAPI) EnableClusterConfiguration(configurationID string) error {
+	*api.calls = append(*api.calls, "enable "+configurationID)
+	return nil
+}
+
+// DisableClusterConfiguration fails
+func (api recordingAPI) DisableClusterConfiguration(configurationID string) error {
+	return errors.New("configuration not found")
+}
+
+// TestJobStartCron function checks the first run of recurring job.
+func TestJobStartCron(t *testing.T) {
+	job := schedule.Job{Cron: "0 2 * * *"}
+	if err := job.Start(start); err != nil {
+		t.Fatal(err)
+	}
+	if !job.NextRun.Equal(time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)) {
+		t.Fatal("Unexpected next run:", job.NextRun)
+	}
+	if job.Due(start) || !job.Due(job.NextRun) {
+		t.Fatal("Job should be due at its next run")
+	}
+	if job.Schedule() != "cron 0 2 * * *" {
+		t.Fatal("Unexpected schedule:", job.Schedule())
+	}
+}
+
+// TestJobStartInvalid function checks that jobs without proper schedule are
+// refused.
+func TestJobStartInvalid(t *testing.T) {
+	past := start.Add(-time.Hour)
+	future := start.Add(time.Hour)
+	invalid := []schedule.Job{
+		{},
+		{Cron: "invalid"},
+		{Cron: "0 0 30 2 *"},
+		{At: &past},
+		{Cron: "@daily", At: &future},
+	}
+	for _, job := range invalid {
+		if err := job.Start(start); err == nil {
+			t.Errorf("Job %+v should be refused", job)
+		}
+	}
+}
+
+// TestJobFinish function checks recording of results and computing of next
+// runs.
+func TestJobFinish(t *testing.T) {
+	at := start.Add(time.Hour)
+	oneShot := schedule.Job{At: &at}
+	if err := oneShot.Start(start); err != nil {
+		t.Fatal(err)
+	}
+	oneShot.Finish(at, errors.New("timeout"))
+	if oneShot.LastResult != "failed: timeout" || !oneShot.NextRun.IsZero() || oneShot.Due(at.Add(time.Hour)) {
+		t.Fatalf("Unexpected state of one-shot job: %+v", oneShot)
+	}
+
+	// missed runs are not repeated
+	recurring := schedule.Job{Cron: "@hourly"}
+	if err := recurring.Start(start); err != nil {
+		t.Fatal(err)
+	}
+	finished := start.Add(5 * time.Hour)
+	recurring.Finish(finished, nil)
+	if recurring.LastResult != "ok" || !recurring.NextRun.Equal(time.Date(2026, 10, 15, 16, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected state of recurring job: %+v", recurring)
+	}
+}
+
+// TestJobFinishUnreachable function checks that claimed run is repeated when
+// the controller could not be reached.
+func TestJobFinishUnreachable(t *testing.T) {
+	at := start.Add(time.Hour)
+	unreachable := []error{
+		cache.ErrOffline,
+		fmt.Errorf("Communication error with the server %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}),
+	}
+	for _, err := range unreachable {
+		oneShot := schedule.Job{At: &at}
+		if err := oneShot.Start(start); err != nil {
+			t.Fatal(err)
+		}
+		oneShot.Claim(at)
+		oneShot.Finish(at.Add(time.Second), err)
+		if !strings.HasPrefix(oneShot.LastResult, "failed: ") || !oneShot.NextRun.Equal(at) || !oneShot.Due(at.Add(time.Minute)) {
+			t.Fatalf("Run should be repeated: %+v", oneShot)
+		}
+
+		// the job is finished when it succeeds
+		oneShot.Claim(at.Add(time.Minute))
+		oneShot.Finish(at.Add(time.Minute), nil)
+		if oneShot.LastResult != "ok" || !oneShot.NextRun.IsZero() {
+			t.Fatalf("Unexpected state of one-shot job: %+v", oneShot)
+		}
+	}
+
+	// error returned by controller is not repeated
+	oneShot := schedule.Job{At: &at}
+	if err := oneShot.Start(start); err != nil {
+		t.Fatal(err)
+	}
+	oneShot.Claim(at)
+	oneShot.Finish(at, errors.New("Error response: cluster not found"))
+	if !oneShot.NextRun.IsZero() {
+		t.Fatalf("Run should not be repeated: %+v", oneShot)
+	}
+}
+
+// TestParseAt function checks parsing of time of one-shot jobs.
+func TestParseAt(t *testing.T) {
+	expected := map[string]time.Time{
+		"2026-10-20 02:00":          time.Date(2026, 10, 20, 2, 0, 0, 0, time.UTC),
+		"2026-10-20T02:00":          time.Date(2026, 10, 20, 2, 0, 0, 0, time.UTC),
+		"2026-10-20T02:00:00+02:00": time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+		"11:00":                     time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC),
+		"10:00":                     time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+	}
+	for value, at := range expected {
+		actual, err := schedule.ParseAt(value, start)
+		if err != nil {
+			t.Fatal(value, err)
+		}
+		if !actual.Equal(at) {
+			t.Errorf("%s: expected %s, got %s", value, at, actual)
+		}
+	}
+	if _, err := schedule.ParseAt("tomorrow", start); err == nil {
+		t.Fatal("Invalid time should be refused")
+	}
+}
+
+// TestExecute function checks that actions are performed via REST API.
+func TestExecute(t *testing.T) {
+	calls := []string{}
+	api := recordingAPI{calls: &calls}
+
+	err := schedule.Execute(api, schedule.Job{Username: "tester", Action: schedule.Action{
+		Kind: schedule.AddTrigger, Cluster: "cluster", TriggerType: "must-gather",
+		Reason: "nightly", Parameters: []byte(`{"timeout":"30m"}`)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = schedule.Execute(api, schedule.Job{Action: schedule.Action{
+		Kind: schedule.EnableConfiguration, ConfigurationID: "42"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{`trigger tester cluster must-gather nightly  {"timeout":"30m"}`, "enable 42"}
+	if strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Fatal("Unexpected calls:", calls)
+	}
+
+	err = schedule.Execute(api, schedule.Job{Action: schedule.Action{
+		Kind: schedule.DisableConfiguration, ConfigurationID: "42"}})
+	if err == nil {
+		t.Fatal("Error should be returned")
+	}
+	err = schedule.Execute(api, schedule.Job{Action: schedule.Action{Kind: "unknown"}})
+	if err == nil {
+		t.Fatal("Unknown action should be refused")
+	}
+}
+
+// TestExecuteMissingManifest function checks that error is returned when
+// manifest can not be read.
+func TestExecuteMissingManifest(t *testing.T) {
+	err := schedule.Execute(recordingAPI{}, schedule.Job{Action: schedule.Action{
+		Kind: schedule.ApplyManifest, Manifest: filepath.Join(t.TempDir(), "manifest.yaml")}})
+	if err == nil {
+		t.Fatal("Error should be returned")
+	}
+}
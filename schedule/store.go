@@ -0,0 +1,222 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/schedule
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/store.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// timing of attempts to lock the store
+const (
+	lockRetryInterval = 50 * time.Millisecond
+	lockTimeout       = 5 * time.Second
+
+	// lock older than this is considered to be left by crashed process
+	staleLockAge = time.Minute
+)
+
+// ErrJobNotFound is returned when job with given ID does not exist
+var ErrJobNotFound = errors.New("scheduled job not found")
+
+// Store represents local file where scheduled jobs are stored. The file is
+// shared by CLI client instances and by daemon, so each change is done under
+// lock and the whole file is rewritten.
+type Store struct {
+	filename string
+}
+
+// DefaultStoreFile function returns path to the file used to store jobs
+// when no other file is specified in configuration.
+func DefaultStoreFile() (string, error) {
+	directory, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(directory, "insights-operator-cli", "schedules.json"), nil
+}
+
+// NewStore function is a constructor to construct new instance of store.
+// The file does not need to exist, it is created when the first job is added.
+func NewStore(filename string) *Store {
+	return &Store{filename: filename}
+}
+
+// Load method reads all jobs from the store, non-existing file is
+// considered to be empty store.
+func (store *Store) Load() ([]Job, error) {
+	// disable "G304 (CWE-22): Potential file inclusion via variable"
+	content, err := os.ReadFile(store.filename) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) {
+		return []Job{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := []Job{}
+	err = json.Unmarshal(content, &jobs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read scheduled jobs: %v", err)
+	}
+	return jobs, nil
+}
+
+// Update method reads all jobs, lets the modify function change them and
+// writes the result back. The whole operation is done under lock.
+func (store *Store) Update(modify func(jobs []Job) ([]Job, error)) error {
+	err := os.MkdirAll(filepath.Dir(store.filename), 0o700)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := store.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	jobs, err := store.Load()
+	if err != nil {
+		return err
+	}
+	jobs, err = modify(jobs)
+	if err != nil {
+		return err
+	}
+	return store.write(jobs)
+}
+
+// Add method stores new job, the job gets the first unused ID.
+func (store *Store) Add(job Job) (Job, error) {
+	err := store.Update(func(jobs []Job) ([]Job, error) {
+		job.ID = 1
+		for _, stored := range jobs {
+			if stored.ID >= job.ID {
+				job.ID = stored.ID + 1
+			}
+		}
+		return append(jobs, job), nil
+	})
+	return job, err
+}
+
+// Remove method deletes job with given ID and returns it.
+func (store *Store) Remove(id int) (Job, error) {
+	var removed Job
+	err := store.Update(func(jobs []Job) ([]Job, error) {
+		for i, job := range jobs {
+			if job.ID == id {
+				removed = job
+				return append(jobs[:i], jobs[i+1:]...), nil
+			}
+		}
+		return nil, ErrJobNotFound
+	})
+	return removed, err
+}
+
+// Claim method marks all jobs that need to be run at given time as running
+// and returns them. Jobs are claimed under lock, so each run is performed by
+// just one daemon, even when more daemons share the same store.
+func (store *Store) Claim(now time.Time) ([]Job, error) {
+	claimed := []Job{}
+	err := store.Update(func(jobs []Job) ([]Job, error) {
+		for i := range jobs {
+			if jobs[i].Due(now) {
+				jobs[i].Claim(now)
+				claimed = append(claimed, jobs[i])
+			}
+		}
+		return jobs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// Finish method records result of job run. Job that has been removed in the
+// meantime is silently skipped.
+func (store *Store) Finish(id int, now time.Time, result error) error {
+	return store.Update(func(jobs []Job) ([]Job, error) {
+		for i := range jobs {
+			if jobs[i].ID == id {
+				jobs[i].Finish(now, result)
+			}
+		}
+		return jobs, nil
+	})
+}
+
+// lock method creates lock file next to the store file and returns function
+// that removes it
+func (store *Store) lock() (func(), error) {
+	lockFile := store.filename + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		// disable "G304 (CWE-22): Potential file inclusion via variable"
+		file, err := os.OpenFile(lockFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600) // #nosec G304
+		if err == nil {
+			_ = file.Close()
+			return func() {
+				_ = os.Remove(lockFile)
+			}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+
+		// lock left by crashed process
+		info, statErr := os.Stat(lockFile)
+		if statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			_ = os.Remove(lockFile)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("scheduled jobs are locked by another process (%s)", lockFile)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// write method writes all jobs into the store file. The file is written
+// under temporary name first so it is not damaged when write fails.
+func (store *Store) write(jobs []Job) error {
+	content, err := json.MarshalIndent(jobs, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	temporary := store.filename + ".tmp"
+	err = os.WriteFile(temporary, content, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(temporary, store.filename)
+}
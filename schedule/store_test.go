@@ -0,0 +1,210 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/schedule/store_test.html
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/schedule"
+)
+
+// newStore function constructs store in temporary directory
+func newStore(t *testing.T) (*schedule.Store, string) {
+	fileName := filepath.Join(t.TempDir(), "jobs", "schedules.json")
+	return schedule.NewStore(fileName), fileName
+}
+
+// TestStoreEmpty function checks that non-existing file is considered to be
+// empty store.
+func TestStoreEmpty(t *testing.T) {
+	store, _ := newStore(t)
+	jobs, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Fatal("Store should be empty")
+	}
+}
+
+// TestStoreAddRemove function checks adding and removing jobs.
+func TestStoreAddRemove(t *testing.T) {
+	store, _ := newStore(t)
+
+	for i := 1; i <= 3; i++ {
+		job, err := store.Add(schedule.Job{Cron: "@daily", Command: "job"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.ID != i {
+			t.Fatal("Unexpected job ID:", job.ID)
+		}
+	}
+
+	removed, err := store.Remove(2)
+	if err != nil || removed.ID != 2 {
+		t.Fatal("Job should be removed:", err)
+	}
+	if _, err := store.Remove(2); !errors.Is(err, schedule.ErrJobNotFound) {
+		t.Fatal("Job should not be found:", err)
+	}
+
+	// IDs of removed jobs are reused only when they are the last ones
+	job, err := store.Add(schedule.Job{At: &start})
+	if err != nil || job.ID != 4 {
+		t.Fatal("Unexpected job ID:", job.ID, err)
+	}
+
+	jobs, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 3 || jobs[0].ID != 1 || jobs[1].ID != 3 || !jobs[2].At.Equal(start) {
+		t.Fatalf("Unexpected jobs: %+v", jobs)
+	}
+}
+
+// TestStoreFinish function checks that results of job runs are stored.
+func TestStoreFinish(t *testing.T) {
+	store, _ := newStore(t)
+	job := schedule.Job{Cron: "@hourly"}
+	if err := job.Start(start); err != nil {
+		t.Fatal(err)
+	}
+	job, err := store.Add(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Finish(job.ID, job.NextRun, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// cancelled jobs are skipped
+	err = store.Finish(100, job.NextRun, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jobs, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobs[0].LastResult != "ok" || !jobs[0].LastRun.Equal(job.NextRun) ||
+		!jobs[0].NextRun.Equal(job.NextRun.Add(time.Hour)) {
+		t.Fatalf("Unexpected job: %+v", jobs[0])
+	}
+}
+
+// TestStoreStaleLock function checks that lock left by crashed process is
+// removed.
+func TestStoreStaleLock(t *testing.T) {
+	store, fileName := newStore(t)
+	err := os.MkdirAll(filepath.Dir(fileName), 0o700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(fileName+".lock", nil, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	err = os.Chtimes(fileName+".lock", old, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Add(schedule.Job{Cron: "@daily"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestStoreInvalidFile function checks that damaged file is reported.
+func TestStoreInvalidFile(t *testing.T) {
+	store, fileName := newStore(t)
+	err := os.MkdirAll(filepath.Dir(fileName), 0o700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(fileName, []byte("{"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); err == nil {
+		t.Fatal("Error should be reported")
+	}
+	if _, err := store.Add(schedule.Job{}); err == nil {
+		t.Fatal("Error should be reported")
+	}
+}
+
+// TestStoreClaim function checks that due job is claimed just once, even by
+// more daemons running at the same time.
+func TestStoreClaim(t *testing.T) {
+	store, _ := newStore(t)
+	now := time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+
+	due := schedule.Job{Cron: "@hourly", Command: "due"}
+	if err := due.Start(now.Add(-2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	later := schedule.Job{Cron: "@daily", Command: "later"}
+	if err := later.Start(now); err != nil {
+		t.Fatal(err)
+	}
+	for _, job := range []schedule.Job{due, later} {
+		if _, err := store.Add(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// more daemons try to claim the jobs at the same time
+	claimed := make(chan []schedule.Job)
+	for i := 0; i < 5; i++ {
+		go func() {
+			jobs, err := store.Claim(now)
+			if err != nil {
+				t.Error(err)
+			}
+			claimed <- jobs
+		}()
+	}
+	commands := []string{}
+	for i := 0; i < 5; i++ {
+		for _, job := range <-claimed {
+			commands = append(commands, job.Command)
+		}
+	}
+	if len(commands) != 1 || commands[0] != "due" {
+		t.Fatal("Due job should be claimed just once:", commands)
+	}
+
+	jobs, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobs[0].LastResult != schedule.ResultRunning || !jobs[0].NextRun.Equal(now.Add(time.Hour)) {
+		t.Fatalf("Claimed job is not marked as running: %+v", jobs[0])
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/triggers
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/triggers/parameters.html
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parameters represents values of trigger parameters, values have the same
+// types as values decoded from JSON
+type Parameters map[string]interface{}
+
+// ParseParameters method parses parameters specified as key=value pairs and
+// validates them. Values are converted according to parameter kind, list
+// items are separated by comma.
+func (triggerType *Type) ParseParameters(pairs []string) (Parameters, error) {
+	parameters := Parameters{}
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("parameter %q is not in key=value form", pair)
+		}
+		if _, exists := parameters[name]; exists {
+			return nil, fmt.Errorf("parameter %s is specified more than once", name)
+		}
+		parameter := triggerType.parameter(name)
+		if parameter == nil {
+			return nil, triggerType.unknownParameter(name)
+		}
+		converted, err := parameter.convert(value)
+		if err != nil {
+			return nil, err
+		}
+		parameters[name] = converted
+	}
+
+	err := triggerType.Validate(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+// ReadParameters method reads parameters from JSON object and validates
+// them
+func (triggerType *Type) ReadParameters(content []byte) (Parameters, error) {
+	parameters, err := decodeParameters(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	err = triggerType.Validate(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+// decodeParameters function decodes parameters stored in trigger. Empty
+// string means no parameters.
+func decodeParameters(encoded string) (Parameters, error) {
+	parameters := Parameters{}
+	if strings.TrimSpace(encoded) == "" {
+		return parameters, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(encoded))
+	decoder.UseNumber()
+	err := decoder.Decode(&parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parameters are not JSON object: %v", err)
+	}
+	return parameters, nil
+}
+
+// Encode method returns parameters encoded into JSON object. Nil is
+// returned when there are no parameters.
+func (parameters Parameters) Encode() ([]byte, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(parameters)
+}
+
+// Validate method checks that all parameters are known, have values of
+// expected kind and that all required parameters are specified
+func (triggerType *Type) Validate(parameters Parameters) error {
+	for name, value := range parameters {
+		parameter := triggerType.parameter(name)
+		if parameter == nil {
+			return triggerType.unknownParameter(name)
+		}
+		err := parameter.check(value)
+		if err != nil {
+			return err
+		}
+	}
+	for _, parameter := range triggerType.Parameters {
+		if _, found := parameters[parameter.Name]; parameter.Required && !found {
+			return fmt.Errorf("parameter %s is required for trigger type %s", parameter.Name, triggerType.Name)
+		}
+	}
+	return nil
+}
+
+// unknownParameter method returns error for parameter that is not supported
+// by trigger type
+func (triggerType *Type) unknownParameter(name string) error {
+	if len(triggerType.Parameters) == 0 {
+		return fmt.Errorf("unknown parameter %s, trigger type %s has no parameters", name, triggerType.Name)
+	}
+	names := make([]string, len(triggerType.Parameters))
+	for i, parameter := range triggerType.Parameters {
+		names[i] = parameter.Name
+	}
+	return fmt.Errorf("unknown parameter %s for trigger type %s, known parameters: %s",
+		name, triggerType.Name, strings.Join(names, ", "))
+}
+
+// convert method converts parameter value entered as text
+func (parameter *Parameter) convert(value string) (interface{}, error) {
+	switch parameter.Kind {
+	case IntParameter:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, parameter.invalid(value)
+		}
+		return json.Number(value), nil
+	case BoolParameter:
+		converted, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, parameter.invalid(value)
+		}
+		return converted, nil
+	case ListParameter:
+		items := []interface{}{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	default:
+		return value, parameter.check(value)
+	}
+}
+
+// check method checks that parameter value has the expected kind
+func (parameter *Parameter) check(value interface{}) error {
+	valid := false
+	switch parameter.Kind {
+	case StringParameter:
+		_, valid = value.(string)
+	case IntParameter:
+		number, ok := value.(json.Number)
+		if ok {
+			_, err := number.Int64()
+			valid = err == nil
+		}
+	case BoolParameter:
+		_, valid = value.(bool)
+	case DurationParameter:
+		text, ok := value.(string)
+		if ok {
+			_, err := time.ParseDuration(text)
+			valid = err == nil
+		}
+	case ListParameter:
+		items, ok := value.([]interface{})
+		valid = ok
+		for _, item := range items {
+			if _, ok := item.(string); !ok {
+				valid = false
+			}
+		}
+	}
+	if !valid {
+		return parameter.invalid(value)
+	}
+	return nil
+}
+
+// invalid method returns error for parameter value of unexpected kind
+func (parameter *Parameter) invalid(value interface{}) error {
+	if text, ok := value.(string); ok {
+		value = strconv.Quote(text)
+	} else if encoded, err := json.Marshal(value); err == nil {
+		value = string(encoded)
+	}
+	return fmt.Errorf("invalid value %v of parameter %s, %s is expected", value, parameter.Name, parameter.Kind)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package triggers contains client-side registry of trigger types that can
+// be requested via controller service. Each trigger type describes its
+// parameters, so parameters entered by user can be validated before the
+// trigger is created. Just must-gather trigger without parameters is known
+// by default, other trigger types (and parameters of must-gather trigger)
+// supported by the controller are read from configuration.
+//
+// Types, functions, and methods from this package are implemented in following
+// source files:
+//
+// * parameters.go
+//
+// * triggers.go
+package triggers
+
+// Generated documentation is available at:
+// https://pkg.go.dev/github.com/RedHatInsights/insights-operator-cli/triggers
+//
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/triggers/triggers.html
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// MustGather is name of trigger type used by default
+const MustGather = "must-gather"
+
+// timestampLayout is layout of timestamps returned by the controller service,
+// fractional seconds and time zone that can follow are ignored
+const timestampLayout = "2006-01-02T15:04:05"
+
+// Acked function checks whether the trigger has been acknowledged by the
+// operator. Triggers that are not acknowledged have the acked_at timestamp
+// set to the start of Unix epoch, timestamps that can't be parsed are not
+// considered to be acknowledgements.
+func Acked(trigger types.Trigger) bool {
+	if len(trigger.AckedAt) < len(timestampLayout) {
+		return false
+	}
+	ackedAt, err := time.Parse(timestampLayout, trigger.AckedAt[:len(timestampLayout)])
+	return err == nil && ackedAt.After(time.Unix(0, 0).UTC())
+}
+
+// ParameterKind represents type of trigger parameter value
+type ParameterKind string
+
+// all supported kinds of parameter values
+const (
+	StringParameter   ParameterKind = "string"
+	IntParameter      ParameterKind = "int"
+	BoolParameter     ParameterKind = "bool"
+	DurationParameter ParameterKind = "duration"
+	ListParameter     ParameterKind = "list"
+)
+
+// Parameter describes one parameter of trigger type
+type Parameter struct {
+	Name        string
+	Kind        ParameterKind
+	Required    bool
+	Description string
+}
+
+// Type describes trigger type and all its parameters
+type Type struct {
+	Name        string
+	Description string
+	Parameters  []Parameter
+}
+
+// builtinTypes contains trigger types supported by the controller without
+// any configuration
+var builtinTypes = []Type{
+	{
+		Name:        MustGather,
+		Description: "gather must-gather data from cluster",
+	},
+}
+
+// registry contains all known trigger types
+var registry = builtinTypes
+
+// Configure function replaces the registry by built-in trigger types and the
+// given configured ones. Configured type with the same name as built-in type
+// replaces it. Registry is not changed when any configured type is invalid.
+func Configure(configured []Type) error {
+	types := append([]Type{}, builtinTypes...)
+	names := map[string]bool{}
+	for _, triggerType := range configured {
+		err := triggerType.check()
+		if err != nil {
+			return err
+		}
+		if names[triggerType.Name] {
+			return fmt.Errorf("trigger type %s is configured more than once", triggerType.Name)
+		}
+		names[triggerType.Name] = true
+
+		replaced := false
+		for i := range types {
+			if types[i].Name == triggerType.Name {
+				types[i] = triggerType
+				replaced = true
+			}
+		}
+		if !replaced {
+			types = append(types, triggerType)
+		}
+	}
+	registry = types
+	return nil
+}
+
+// check method checks that trigger type is properly described
+func (triggerType *Type) check() error {
+	if triggerType.Name == "" {
+		return fmt.Errorf("trigger type has no name")
+	}
+	names := map[string]bool{}
+	for _, parameter := range triggerType.Parameters {
+		if parameter.Name == "" {
+			return fmt.Errorf("parameter of trigger type %s has no name", triggerType.Name)
+		}
+		if names[parameter.Name] {
+			return fmt.Errorf("parameter %s of trigger type %s is described more than once",
+				parameter.Name, triggerType.Name)
+		}
+		names[parameter.Name] = true
+
+		switch parameter.Kind {
+		case StringParameter, IntParameter, BoolParameter, DurationParameter, ListParameter:
+		default:
+			return fmt.Errorf("parameter %s of trigger type %s has unknown kind %q",
+				parameter.Name, triggerType.Name, parameter.Kind)
+		}
+	}
+	return nil
+}
+
+// Names function returns names of all known trigger types
+func Names() []string {
+	names := make([]string, len(registry))
+	for i, triggerType := range registry {
+		names[i] = triggerType.Name
+	}
+	return names
+}
+
+// Lookup function returns trigger type with given name
+func Lookup(name string) (*Type, error) {
+	for i := range registry {
+		if registry[i].Name == name {
+			return &registry[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown trigger type %q, known types: %s", name, strings.Join(Names(), ", "))
+}
+
+// parameter method returns description of parameter with given name
+func (triggerType *Type) parameter(name string) *Parameter {
+	for i := range triggerType.Parameters {
+		if triggerType.Parameters[i].Name == name {
+			return &triggerType.Parameters[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+Copyright © 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers_test
+
+// Documentation in literate-programming-style is available at:
+// https://redhatinsights.github.io/insights-operator-cli/packages/triggers/triggers_test.html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RedHatInsights/insights-operator-cli/triggers"
+	"github.com/RedHatInsights/insights-operator-cli/types"
+)
+
+// useConfiguredTypes function configures trigger type with parameters of
+// all kinds, the returned function restores the built-in registry
+func useConfiguredTypes(t *testing.T) func() {
+	err := triggers.Configure([]triggers.Type{
+		{
+			Name:        "custom-gather",
+			Description: "custom data gathering",
+			Parameters: []triggers.Parameter{
+				{Name: "gatherers", Kind: triggers.ListParameter},
+				{Name: "upload", Kind: triggers.BoolParameter},
+				{Name: "timeout", Kind: triggers.DurationParameter},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		_ = triggers.Configure(nil)
+	}
+}
+
+// lookup function returns trigger type that has to exist
+func lookup(t *testing.T, name string) *triggers.Type {
+	triggerType, err := triggers.Lookup(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return triggerType
+}
+
+// TestLookupUnknownType checks that known types are listed in error message
+func TestLookupUnknownType(t *testing.T) {
+	defer useConfiguredTypes(t)()
+
+	_, err := triggers.Lookup("unknown")
+	if err == nil || !strings.Contains(err.Error(), "must-gather, custom-gather") {
+		t.Fatal("Error with list of known types is expected", err)
+	}
+}
+
+// TestBuiltinTypes checks that just must-gather trigger without parameters is
+// known by default
+func TestBuiltinTypes(t *testing.T) {
+	if names := triggers.Names(); len(names) != 1 || names[0] != triggers.MustGather {
+		t.Fatal("Unexpected built-in trigger types:", names)
+	}
+	_, err := lookup(t, triggers.MustGather).ParseParameters([]string{"image=quay.io/a"})
+	if err == nil || !strings.Contains(err.Error(), "has no parameters") {
+		t.Fatal("Parameters of must-gather trigger should be refused", err)
+	}
+}
+
+// TestConfigure checks that configured trigger types are added to the
+// registry and that they can replace built-in types
+func TestConfigure(t *testing.T) {
+	defer func() {
+		_ = triggers.Configure(nil)
+	}()
+
+	err := triggers.Configure([]triggers.Type{{
+		Name:       triggers.MustGather,
+		Parameters: []triggers.Parameter{{Name: "image", Kind: triggers.StringParameter}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = lookup(t, triggers.MustGather).ParseParameters([]string{"image=quay.io/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(triggers.Names()) != 1 {
+		t.Fatal("Built-in type should be replaced:", triggers.Names())
+	}
+}
+
+// TestConfigureInvalidTypes checks that invalid configuration is refused and
+// the registry is not changed
+func TestConfigureInvalidTypes(t *testing.T) {
+	invalid := map[string][]triggers.Type{
+		"no name":           {{Description: "x"}},
+		"duplicate type":    {{Name: "a"}, {Name: "a"}},
+		"no parameter name": {{Name: "a", Parameters: []triggers.Parameter{{Kind: triggers.IntParameter}}}},
+		"unknown kind":      {{Name: "a", Parameters: []triggers.Parameter{{Name: "p", Kind: "float"}}}},
+		"duplicate parameter": {{Name: "a", Parameters: []triggers.Parameter{
+			{Name: "p", Kind: triggers.IntParameter}, {Name: "p", Kind: triggers.BoolParameter}}}},
+	}
+	for name, configured := range invalid {
+		if triggers.Configure(configured) == nil {
+			t.Error("Configuration should be refused:", name)
+		}
+		if len(triggers.Names()) != 1 {
+			t.Fatal("Registry should not be changed:", triggers.Names())
+		}
+	}
+}
+
+// TestTypesHaveUniqueNames checks consistency of the registry
+func TestTypesHaveUniqueNames(t *testing.T) {
+	names := map[string]bool{}
+	for _, name := range triggers.Names() {
+		triggerType := lookup(t, name)
+		if names[triggerType.Name] {
+			t.Fatal("Trigger type is registered more than once:", triggerType.Name)
+		}
+		names[triggerType.Name] = true
+		if triggerType.Description == "" {
+			t.Fatal("Trigger type has no description:", triggerType.Name)
+		}
+	}
+}
+
+// TestParseParameters checks conversion of parameters entered as key=value
+// pairs
+func TestParseParameters(t *testing.T) {
+	defer useConfiguredTypes(t)()
+
+	parameters, err := lookup(t, "custom-gather").ParseParameters(
+		[]string{"gatherers=clusterconfig, workloads", "upload=true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := parameters.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"gatherers":["clusterconfig","workloads"],"upload":true}` {
+		t.Fatal("Unexpected parameters:", string(encoded))
+	}
+}
+
+// TestParseNoParameters checks that trigger without parameters has no body
+func TestParseNoParameters(t *testing.T) {
+	parameters, err := lookup(t, triggers.MustGather).ParseParameters(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := parameters.Encode()
+	if err != nil || encoded != nil {
+		t.Fatal("No parameters are expected", encoded, err)
+	}
+}
+
+// TestParseInvalidParameters checks that invalid parameters are refused
+func TestParseInvalidParameters(t *testing.T) {
+	defer useConfiguredTypes(t)()
+
+	invalid := map[string][]string{
+		"not key=value":   {"gatherers"},
+		"empty key":       {"=value"},
+		"unknown":         {"unknown=1"},
+		"duplicate":       {"gatherers=a", "gatherers=b"},
+		"invalid timeout": {"timeout=soon"},
+	}
+	for name, pairs := range invalid {
+		_, err := lookup(t, "custom-gather").ParseParameters(pairs)
+		if err == nil {
+			t.Error("Parameters should be refused:", name)
+		}
+	}
+
+	_, err := lookup(t, "custom-gather").ParseParameters([]string{"upload=maybe"})
+	if err == nil || !strings.Contains(err.Error(), "bool is expected") {
+		t.Fatal("Invalid bool value should be refused", err)
+	}
+}
+
+// TestReadParameters checks validation of parameters read from JSON
+func TestReadParameters(t *testing.T) {
+	defer useConfiguredTypes(t)()
+	triggerType := lookup(t, "custom-gather")
+
+	parameters, err := triggerType.ReadParameters([]byte(`{"gatherers": ["a"], "upload": false}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parameters) != 2 {
+		t.Fatal("Unexpected parameters:", parameters)
+	}
+
+	// empty content means no parameters
+	parameters, err = lookup(t, triggers.MustGather).ReadParameters([]byte(" "))
+	if err != nil || len(parameters) != 0 {
+		t.Fatal("Empty parameters are expected", parameters, err)
+	}
+
+	invalid := []string{
+		`[]`,
+		`not JSON`,
+		`{"gatherers": "a"}`,
+		`{"gatherers": [1]}`,
+		`{"upload": "yes"}`,
+		`{"unknown": 1}`,
+	}
+	for _, content := range invalid {
+		_, err := triggerType.ReadParameters([]byte(content))
+		if err == nil {
+			t.Error("Parameters should be refused:", content)
+		}
+	}
+}
+
+// TestRequiredParameter checks that missing required parameter is reported
+func TestRequiredParameter(t *testing.T) {
+	triggerType := triggers.Type{
+		Name:       "test",
+		Parameters: []triggers.Parameter{{Name: "count", Kind: triggers.IntParameter, Required: true}},
+	}
+	_, err := triggerType.ParseParameters(nil)
+	if err == nil || !strings.Contains(err.Error(), "parameter count is required") {
+		t.Fatal("Missing parameter should be reported", err)
+	}
+	_, err = triggerType.ParseParameters([]string{"count=x"})
+	if err == nil {
+		t.Fatal("Invalid int value should be refused")
+	}
+	_, err = triggerType.ParseParameters([]string{"count=42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAcked checks recognition of acknowledged triggers, including
+// timestamps with fractional seconds and time zone
+func TestAcked(t *testing.T) {
+	expected := map[string]bool{
+		"2020-01-01T00:00:00":           true,
+		"2020-01-01T00:00:00Z":          true,
+		"2020-01-01T00:00:00.123+02:00": true,
+		"1970-01-01T00:00:01":           true,
+		"1970-01-01T00:00:00":           false,
+		"1970-01-01T00:00:00Z":          false,
+		"1970-01-01T00:00:00.000":       false,
+		"1970":                          false,
+		"":                              false,
+		"not a timestamp at all":        false,
+	}
+	for ackedAt, acked := range expected {
+		if triggers.Acked(types.Trigger{AckedAt: ackedAt}) != acked {
+			t.Errorf("Unexpected result for %q, expected %t", ackedAt, acked)
+		}
+	}
+}
@@ -28,8 +28,8 @@ package types
 //	Name: cluster GUID in the following format:
 //	    c8590f31-e97e-4b85-b506-c45ce1911a12
 type Cluster struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID   int    `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
 }
 
 // ClustersResponse structure represents response of controller service to
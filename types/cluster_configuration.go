@@ -33,13 +33,13 @@ package types
 //	Active: flag indicating whether the configuration is active or not
 //	Reason: a string with any comment(s) about the cluster configuration
 type ClusterConfiguration struct {
-	ID            int    `json:"id"`
-	Cluster       string `json:"cluster"`
-	Configuration string `json:"configuration"`
-	ChangedAt     string `json:"changed_at"`
-	ChangedBy     string `json:"changed_by"`
-	Active        string `json:"active"`
-	Reason        string `json:"reason"`
+	ID            int    `json:"id" yaml:"id"`
+	Cluster       string `json:"cluster" yaml:"cluster"`
+	Configuration string `json:"configuration" yaml:"configuration"`
+	ChangedAt     string `json:"changed_at" yaml:"changed_at"`
+	ChangedBy     string `json:"changed_by" yaml:"changed_by"`
+	Active        string `json:"active" yaml:"active"`
+	Reason        string `json:"reason" yaml:"reason"`
 }
 
 // ClusterConfigurationsResponse represents response of controller service to cluster configuration request.
@@ -31,11 +31,11 @@ package types
 //	ChangeBy: timestamp of the last configuration change
 //	Description: a string with any comment(s) about the configuration
 type ConfigurationProfile struct {
-	ID            int    `json:"id"`
-	Configuration string `json:"configuration"`
-	ChangedAt     string `json:"changed_at"`
-	ChangedBy     string `json:"changed_by"`
-	Description   string `json:"description"`
+	ID            int    `json:"id" yaml:"id"`
+	Configuration string `json:"configuration" yaml:"configuration"`
+	ChangedAt     string `json:"changed_at" yaml:"changed_at"`
+	ChangedBy     string `json:"changed_by" yaml:"changed_by"`
+	Description   string `json:"description" yaml:"description"`
 }
 
 // ConfigurationProfilesResponse structure represents response of controller
@@ -35,16 +35,16 @@ package types
 //	Parameters: parameters that needs to be pass to trigger code
 //	Active: flag indicating whether the trigger is still active or not
 type Trigger struct {
-	ID          int    `json:"id"`
-	Type        string `json:"type"`
-	Cluster     string `json:"cluster"`
-	Reason      string `json:"reason"`
-	Link        string `json:"link"`
-	TriggeredAt string `json:"triggered_at"`
-	TriggeredBy string `json:"triggered_by"`
-	AckedAt     string `json:"acked_at"`
-	Parameters  string `json:"parameters"`
-	Active      int    `json:"active"`
+	ID          int    `json:"id" yaml:"id"`
+	Type        string `json:"type" yaml:"type"`
+	Cluster     string `json:"cluster" yaml:"cluster"`
+	Reason      string `json:"reason" yaml:"reason"`
+	Link        string `json:"link" yaml:"link"`
+	TriggeredAt string `json:"triggered_at" yaml:"triggered_at"`
+	TriggeredBy string `json:"triggered_by" yaml:"triggered_by"`
+	AckedAt     string `json:"acked_at" yaml:"acked_at"`
+	Parameters  string `json:"parameters" yaml:"parameters"`
+	Active      int    `json:"active" yaml:"active"`
 }
 
 // TriggersResponse structure represents response of controller service to